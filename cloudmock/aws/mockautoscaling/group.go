@@ -67,6 +67,25 @@ func (m *MockAutoscaling) DescribeAutoScalingGroups(input *autoscaling.DescribeA
 	}, nil
 }
 
+func (m *MockAutoscaling) DetachInstances(input *autoscaling.DetachInstancesInput) (*autoscaling.DetachInstancesOutput, error) {
+	for _, group := range m.Groups {
+		if aws.StringValue(group.AutoScalingGroupName) != aws.StringValue(input.AutoScalingGroupName) {
+			continue
+		}
+		for _, instanceID := range input.InstanceIds {
+			for i := range group.Instances {
+				if aws.StringValue(group.Instances[i].InstanceId) == aws.StringValue(instanceID) {
+					group.Instances = append(group.Instances[:i], group.Instances[i+1:]...)
+					break
+				}
+			}
+		}
+		return &autoscaling.DetachInstancesOutput{}, nil
+	}
+
+	return nil, fmt.Errorf("Group not found")
+}
+
 func (m *MockAutoscaling) TerminateInstanceInAutoScalingGroup(input *autoscaling.TerminateInstanceInAutoScalingGroupInput) (*autoscaling.TerminateInstanceInAutoScalingGroupOutput, error) {
 	for _, group := range m.Groups {
 		for i := range group.Instances {
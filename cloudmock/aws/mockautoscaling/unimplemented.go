@@ -373,11 +373,6 @@ func (m *MockAutoscaling) DetachInstancesRequest(*autoscaling.DetachInstancesInp
 	return nil, nil
 }
 
-func (m *MockAutoscaling) DetachInstances(*autoscaling.DetachInstancesInput) (*autoscaling.DetachInstancesOutput, error) {
-	log.Fatal("Not implemented")
-	return nil, nil
-}
-
 func (m *MockAutoscaling) DetachLoadBalancerTargetGroupsRequest(*autoscaling.DetachLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.DetachLoadBalancerTargetGroupsOutput) {
 	log.Fatal("Not implemented")
 	return nil, nil
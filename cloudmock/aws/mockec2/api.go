@@ -44,6 +44,8 @@ type MockEC2 struct {
 
 	vpcNumber int
 	Vpcs      map[string]*vpcInfo
+
+	TerminatedInstanceIds []string
 }
 
 var _ ec2iface.EC2API = &MockEC2{}
@@ -1426,10 +1426,6 @@ func (m *MockEC2) TerminateInstancesRequest(*ec2.TerminateInstancesInput) (*requ
 	panic("Not implemented")
 	return nil, nil
 }
-func (m *MockEC2) TerminateInstances(*ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error) {
-	panic("Not implemented")
-	return nil, nil
-}
 func (m *MockEC2) UnassignPrivateIpAddressesRequest(*ec2.UnassignPrivateIpAddressesInput) (*request.Request, *ec2.UnassignPrivateIpAddressesOutput) {
 	panic("Not implemented")
 	return nil, nil
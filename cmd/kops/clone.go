@@ -0,0 +1,51 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	clone_long = templates.LongDesc(i18n.T(`
+	Clone a resource from an existing one.`))
+
+	clone_example = templates.Examples(i18n.T(`
+	# Clone a cluster into another region
+	kops clone cluster --from k8s.example.com --to dr.example.com --region us-west-2
+	`))
+
+	clone_short = i18n.T(`Clone a resource.`)
+)
+
+func NewCmdClone(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clone",
+		Short:   clone_short,
+		Long:    clone_long,
+		Example: clone_example,
+	}
+
+	cmd.AddCommand(NewCmdCloneCluster(f, out))
+
+	return cmd
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	clone_cluster_long = templates.LongDesc(i18n.T(`
+	Create a new cluster from the spec of an existing one, rewriting the zones,
+	subnets and DNS names for the target region.  Only the configuration is
+	cloned: secrets and keys are regenerated when the new cluster is first
+	updated, and no cloud resources are created until kops update cluster.
+
+	Zones are rewritten by keeping their letter suffix and substituting the
+	target region (us-east-1a becomes us-west-2a for --region us-west-2);
+	individual zones can be overridden with --zone-mapping.`))
+
+	clone_cluster_example = templates.Examples(i18n.T(`
+	# Clone a cluster into us-west-2 for DR
+	kops clone cluster --from k8s.example.com --to dr.example.com --region us-west-2
+
+	# Clone with an explicit zone mapping
+	kops clone cluster --from k8s.example.com --to dr.example.com \
+	  --zone-mapping us-east-1a=eu-west-1a,us-east-1b=eu-west-1c
+
+	# Then create the cloud resources
+	kops update cluster dr.example.com --yes
+	`))
+
+	clone_cluster_short = i18n.T(`Clone the spec of an existing cluster.`)
+)
+
+type CloneClusterOptions struct {
+	// From is the name of the cluster to clone
+	From string
+	// To is the name of the cluster to create
+	To string
+	// Region is the target region; zones keep their letter suffix
+	Region string
+	// ZoneMapping is a list of oldzone=newzone overrides
+	ZoneMapping []string
+}
+
+func NewCmdCloneCluster(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &CloneClusterOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "cluster",
+		Short:   clone_cluster_short,
+		Long:    clone_cluster_long,
+		Example: clone_cluster_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCloneCluster(f, os.Stdout, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&options.From, "from", options.From, "Name of the cluster to clone")
+	cmd.Flags().StringVar(&options.To, "to", options.To, "Name of the cluster to create")
+	cmd.Flags().StringVar(&options.Region, "region", options.Region, "Target region; zones are rewritten keeping their letter suffix")
+	cmd.Flags().StringSliceVar(&options.ZoneMapping, "zone-mapping", options.ZoneMapping, "Explicit zone rewrites, as oldzone=newzone pairs")
+
+	return cmd
+}
+
+func RunCloneCluster(f *util.Factory, out io.Writer, options *CloneClusterOptions) error {
+	if options.From == "" {
+		return fmt.Errorf("--from is required")
+	}
+	if options.To == "" {
+		return fmt.Errorf("--to is required")
+	}
+	if options.To == options.From {
+		return fmt.Errorf("--to must differ from --from")
+	}
+
+	zoneMapping := make(map[string]string)
+	for _, s := range options.ZoneMapping {
+		tokens := strings.SplitN(s, "=", 2)
+		if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" {
+			return fmt.Errorf("invalid zone mapping %q: expected oldzone=newzone", s)
+		}
+		zoneMapping[tokens[0]] = tokens[1]
+	}
+
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	source, err := clientset.GetCluster(options.From)
+	if err != nil {
+		return err
+	}
+	if source == nil {
+		return fmt.Errorf("cluster %q not found", options.From)
+	}
+
+	if existing, err := clientset.GetCluster(options.To); err == nil && existing != nil {
+		return fmt.Errorf("cluster %q already exists", options.To)
+	}
+
+	sourceGroups, err := clientset.InstanceGroupsFor(source).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", options.From, err)
+	}
+
+	cluster, err := cloneClusterObject(source)
+	if err != nil {
+		return err
+	}
+
+	cluster.ObjectMeta = metav1.ObjectMeta{Name: options.To}
+
+	// These are derived from the cluster name or the target cloud; let
+	// creation recompute them
+	cluster.Spec.ConfigBase = ""
+	cluster.Spec.DNSZone = ""
+	cluster.Spec.MasterPublicName = ""
+	cluster.Spec.MasterInternalName = ""
+	cluster.Spec.NetworkID = ""
+	cluster.Spec.KeyStore = ""
+	cluster.Spec.SecretStore = ""
+	cluster.Spec.ConfigStore = ""
+
+	for i := range cluster.Spec.Subnets {
+		subnet := &cluster.Spec.Subnets[i]
+		newZone, err := mapCloneZone(subnet.Zone, options.Region, zoneMapping)
+		if err != nil {
+			return err
+		}
+		subnet.Name = strings.Replace(subnet.Name, subnet.Zone, newZone, 1)
+		subnet.Zone = newZone
+		// Provider ids belong to the source region/account
+		subnet.ProviderID = ""
+	}
+
+	var instanceGroups []*api.InstanceGroup
+	for i := range sourceGroups.Items {
+		ig, err := cloneInstanceGroupObject(&sourceGroups.Items[i])
+		if err != nil {
+			return err
+		}
+		ig.ObjectMeta = metav1.ObjectMeta{Name: ig.ObjectMeta.Name}
+
+		for j, subnetName := range ig.Spec.Subnets {
+			for oldZone, newZone := range zoneMapping {
+				subnetName = strings.Replace(subnetName, oldZone, newZone, 1)
+			}
+			if options.Region != "" {
+				if newZone, err := mapCloneZone(zoneFromSubnetName(subnetName), options.Region, zoneMapping); err == nil {
+					subnetName = strings.Replace(subnetName, zoneFromSubnetName(subnetName), newZone, 1)
+				}
+			}
+			ig.Spec.Subnets[j] = subnetName
+		}
+
+		if strings.HasPrefix(ig.Spec.Image, "ami-") {
+			glog.Warningf("instance group %q uses image %q; AMI ids are region-specific and must be updated for the target region", ig.ObjectMeta.Name, ig.Spec.Image)
+		}
+
+		instanceGroups = append(instanceGroups, ig)
+	}
+
+	configBase, err := clientset.ConfigBaseFor(cluster)
+	if err != nil {
+		return fmt.Errorf("error building ConfigBase for cluster: %v", err)
+	}
+	cluster.Spec.ConfigBase = configBase.Path()
+
+	err = cloudup.PerformAssignments(cluster)
+	if err != nil {
+		return fmt.Errorf("error populating configuration: %v", err)
+	}
+	err = api.PerformAssignmentsInstanceGroups(instanceGroups)
+	if err != nil {
+		return fmt.Errorf("error populating configuration: %v", err)
+	}
+
+	err = validation.DeepValidate(cluster, instanceGroups, false)
+	if err != nil {
+		return err
+	}
+
+	err = registry.CreateClusterConfig(clientset, cluster, instanceGroups)
+	if err != nil {
+		return fmt.Errorf("error writing cloned configuration: %v", err)
+	}
+
+	fmt.Fprintf(out, "Cloned cluster %q to %q\n", options.From, options.To)
+	fmt.Fprintf(out, "\nSecrets and keys were not copied; they will be generated when the cluster is created.\n")
+	fmt.Fprintf(out, "\nSuggestions:\n")
+	fmt.Fprintf(out, " * review the spec: kops edit cluster %s\n", options.To)
+	fmt.Fprintf(out, " * create the cloud resources: kops update cluster %s --yes\n", options.To)
+	return nil
+}
+
+// cloneClusterObject deep-copies a cluster by round-tripping through the
+// versioned API
+func cloneClusterObject(cluster *api.Cluster) (*api.Cluster, error) {
+	data, err := api.ToVersionedYaml(cluster)
+	if err != nil {
+		return nil, err
+	}
+	o, _, err := api.ParseVersionedYaml(data)
+	if err != nil {
+		return nil, err
+	}
+	copied, ok := o.(*api.Cluster)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T cloning cluster", o)
+	}
+	return copied, nil
+}
+
+// cloneInstanceGroupObject deep-copies an instance group by round-tripping
+// through the versioned API
+func cloneInstanceGroupObject(ig *api.InstanceGroup) (*api.InstanceGroup, error) {
+	data, err := api.ToVersionedYaml(ig)
+	if err != nil {
+		return nil, err
+	}
+	o, _, err := api.ParseVersionedYaml(data)
+	if err != nil {
+		return nil, err
+	}
+	copied, ok := o.(*api.InstanceGroup)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T cloning instance group", o)
+	}
+	return copied, nil
+}
+
+// mapCloneZone rewrites an availability zone for the target region: an explicit
+// mapping wins, otherwise the zone keeps its letter suffix in the new region
+func mapCloneZone(zone string, region string, zoneMapping map[string]string) (string, error) {
+	if newZone, ok := zoneMapping[zone]; ok {
+		return newZone, nil
+	}
+	if region == "" || zone == "" {
+		return zone, nil
+	}
+
+	letter := zone[len(zone)-1:]
+	if letter < "a" || letter > "z" {
+		return "", fmt.Errorf("cannot map zone %q to region %q; use --zone-mapping", zone, region)
+	}
+	return region + letter, nil
+}
+
+// zoneFromSubnetName extracts the zone from subnet names like "us-east-1a" or
+// "utility-us-east-1a"
+func zoneFromSubnetName(name string) string {
+	return strings.TrimPrefix(name, "utility-")
+}
@@ -51,6 +51,41 @@ var (
 	}
 )
 
+// bashCompletionFunc completes resource names dynamically, via the hidden
+// completion-names command, which queries the state store with a short cache
+const bashCompletionFunc = `
+__kops_get_clusters()
+{
+    local kops_output
+    if kops_output=$(kops completion-names clusters 2>/dev/null); then
+        COMPREPLY=( $(compgen -W "${kops_output}" -- "$cur") )
+    fi
+}
+
+__kops_get_instancegroups()
+{
+    local kops_output
+    if kops_output=$(kops completion-names instancegroups 2>/dev/null); then
+        COMPREPLY=( $(compgen -W "${kops_output}" -- "$cur") )
+    fi
+}
+
+__custom_func() {
+    case ${last_command} in
+        kops_delete_cluster | kops_edit_cluster | kops_export_kubecfg | kops_update_cluster | kops_validate_cluster)
+            __kops_get_clusters
+            return
+            ;;
+        kops_delete_instancegroup | kops_edit_instancegroup)
+            __kops_get_instancegroups
+            return
+            ;;
+        *)
+            ;;
+    esac
+}
+`
+
 type CompletionOptions struct {
 	Shell string
 }
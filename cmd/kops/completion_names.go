@@ -0,0 +1,155 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+)
+
+// completionCacheTTL is how long completion lookups are cached; shell completion
+// runs on every <TAB>, so we must not hit the state store each time
+const completionCacheTTL = 2 * time.Minute
+
+// NewCmdCompletionNames is the hidden backend for dynamic shell completion: it
+// prints the names of clusters or instance groups from the state store, one per
+// line, caching the results briefly on disk
+func NewCmdCompletionNames(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "completion-names KIND",
+		Short:  "Print resource names for shell completion",
+		Hidden: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				exitWithError(fmt.Errorf("expected a single argument: clusters or instancegroups"))
+			}
+
+			err := RunCompletionNames(f, out, args[0])
+			if err != nil {
+				// Completion must never break the shell; fail silently
+				os.Exit(1)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func RunCompletionNames(f *util.Factory, out io.Writer, kind string) error {
+	cacheKey := rootCommand.RegistryPath + "/" + kind
+	if kind == "instancegroups" {
+		cacheKey += "/" + rootCommand.ClusterName()
+	}
+
+	if names, ok := readCompletionCache(cacheKey); ok {
+		fmt.Fprintf(out, "%s", names)
+		return nil
+	}
+
+	clientSet, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	switch kind {
+	case "clusters":
+		clusterList, err := clientSet.ListClusters(metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for i := range clusterList.Items {
+			names = append(names, clusterList.Items[i].ObjectMeta.Name)
+		}
+
+	case "instancegroups":
+		cluster, err := rootCommand.Cluster()
+		if err != nil {
+			return err
+		}
+		igList, err := clientSet.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for i := range igList.Items {
+			names = append(names, igList.Items[i].ObjectMeta.Name)
+		}
+
+	default:
+		return fmt.Errorf("unknown kind %q", kind)
+	}
+
+	output := strings.Join(names, "\n")
+	if output != "" {
+		output += "\n"
+	}
+	writeCompletionCache(cacheKey, output)
+
+	fmt.Fprintf(out, "%s", output)
+	return nil
+}
+
+// completionCachePath maps a cache key to a file under the user's kops directory
+func completionCachePath(cacheKey string) string {
+	home := os.Getenv("HOME")
+	if home == "" {
+		return ""
+	}
+	hash := fmt.Sprintf("%x", sha1.Sum([]byte(cacheKey)))
+	return filepath.Join(home, ".kops", "completion-cache", hash)
+}
+
+func readCompletionCache(cacheKey string) (string, bool) {
+	p := completionCachePath(cacheKey)
+	if p == "" {
+		return "", false
+	}
+
+	stat, err := os.Stat(p)
+	if err != nil || time.Since(stat.ModTime()) > completionCacheTTL {
+		return "", false
+	}
+
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+func writeCompletionCache(cacheKey string, names string) {
+	p := completionCachePath(cacheKey)
+	if p == "" {
+		return
+	}
+
+	// Best effort: completion still works without the cache
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(p, []byte(names), 0600)
+}
@@ -102,6 +102,12 @@ func RunDeleteCluster(f *util.Factory, out io.Writer, options *DeleteClusterOpti
 		return fmt.Errorf("--name is required (for safety)")
 	}
 
+	if options.Yes {
+		if err := rootCommand.AssumeRoleForMutation(); err != nil {
+			return err
+		}
+	}
+
 	var cloud fi.Cloud
 	var cluster *api.Cluster
 	var err error
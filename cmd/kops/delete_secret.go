@@ -45,6 +45,9 @@ type DeleteSecretOptions struct {
 	SecretType  string
 	SecretName  string
 	SecretID    string
+
+	// Force allows deleting a secret that the model still references
+	Force bool
 }
 
 func NewCmdDeleteSecret(f *util.Factory, out io.Writer) *cobra.Command {
@@ -75,6 +78,8 @@ func NewCmdDeleteSecret(f *util.Factory, out io.Writer) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&options.Force, "force", options.Force, "Delete the secret even if the cluster still references it")
+
 	return cmd
 }
 
@@ -128,6 +133,10 @@ func RunDeleteSecret(f *util.Factory, out io.Writer, options *DeleteSecretOption
 		return fmt.Errorf("found multiple matching secrets; specify the id of the key")
 	}
 
+	if usage := modelSecretUsage[secrets[0].Name]; usage != "" && !options.Force {
+		return fmt.Errorf("secret %q is still referenced by the cluster (%s); deleting it can break the cluster.  Pass --force to delete it anyway", secrets[0].Name, usage)
+	}
+
 	err = keyStore.DeleteSecret(secrets[0])
 	if err != nil {
 		return fmt.Errorf("error deleting secret: %v", err)
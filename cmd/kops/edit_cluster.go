@@ -30,6 +30,7 @@ import (
 	"k8s.io/kops/cmd/kops/util"
 	api "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/pkg/deprecation"
 	"k8s.io/kops/pkg/apis/kops/validation"
 	"k8s.io/kops/pkg/edit"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
@@ -229,6 +230,10 @@ func RunEditCluster(f *util.Factory, cmd *cobra.Command, args []string, out io.W
 			continue
 		}
 
+		for _, warning := range deprecation.FindDeprecations(newCluster, instancegroups) {
+			fmt.Fprintf(out, "Warning: %s\n", warning)
+		}
+
 		configBase, err := registry.ConfigBase(newCluster)
 		if err != nil {
 			return preservedFile(err, file, out)
@@ -17,11 +17,15 @@ limitations under the License.
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"strings"
 
+	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"io"
 	"k8s.io/kops/cmd/kops/util"
@@ -51,6 +55,79 @@ type GetSecretsOptions struct {
 	Type string
 }
 
+// modelSecretUsage maps the secrets and keypairs the kops model creates to
+// the components that reference them.  Shown in the output, and consulted by
+// kops delete secret to guard against deleting a secret the cluster depends
+// on.
+var modelSecretUsage = map[string]string{
+	// Keypairs, from pkg/model/pki.go
+	"ca":                      "cluster CA; signs every other certificate",
+	"master":                  "kube-apiserver serving certificate",
+	"kube-scheduler":          "kube-scheduler client certificate",
+	"kube-controller-manager": "kube-controller-manager client certificate",
+	"kube-router":             "kube-router client certificate",
+	"kubecfg":                 "admin kubeconfig client certificate",
+	"kops":                    "kops and protokube client certificate",
+
+	// Both a keypair and a token
+	"kubelet":    "kubelet client certificate and token",
+	"kube-proxy": "kube-proxy client certificate and token",
+
+	// Tokens, from upup/models/cloudup/tokens
+	"kube":                      "admin basic-auth password",
+	"admin":                     "admin token and ssh public key",
+	"system:scheduler":          "kube-scheduler token",
+	"system:controller_manager": "kube-controller-manager token",
+	"system:logging":            "logging token",
+	"system:monitoring":         "monitoring token",
+	"system:dns":                "kube-dns token",
+}
+
+// secretInfo is a secret with the metadata that the plain keystore listing
+// does not carry
+type secretInfo struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	ID   string `json:"id,omitempty"`
+
+	// NotBefore and NotAfter are the certificate validity bounds; keypairs only
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	NotAfter  *time.Time `json:"notAfter,omitempty"`
+
+	// UsedBy says which components reference the secret, for the secrets the
+	// model knows about
+	UsedBy string `json:"usedBy,omitempty"`
+}
+
+// buildSecretInfos joins the keystore listing with certificate metadata and
+// the model's usage information
+func buildSecretInfos(keyStore fi.CAStore, items []*fi.KeystoreItem) []*secretInfo {
+	var infos []*secretInfo
+	for _, item := range items {
+		info := &secretInfo{
+			Type:   item.Type,
+			Name:   item.Name,
+			ID:     item.Id,
+			UsedBy: modelSecretUsage[item.Name],
+		}
+
+		if item.Type == fi.SecretTypeKeypair {
+			cert, err := keyStore.FindCert(item.Name)
+			if err != nil {
+				glog.Warningf("cannot read certificate %q: %v", item.Name, err)
+			} else if cert != nil && cert.Certificate != nil {
+				notBefore := cert.Certificate.NotBefore
+				notAfter := cert.Certificate.NotAfter
+				info.NotBefore = &notBefore
+				info.NotAfter = &notAfter
+			}
+		}
+
+		infos = append(infos, info)
+	}
+	return infos
+}
+
 func NewCmdGetSecrets(f *util.Factory, out io.Writer, getOptions *GetOptions) *cobra.Command {
 	options := GetSecretsOptions{
 		GetOptions: getOptions,
@@ -171,23 +248,42 @@ func RunGetSecrets(options *GetSecretsOptions, args []string) error {
 	switch options.output {
 
 	case OutputTable:
+		infos := buildSecretInfos(keyStore, items)
 
 		t := &tables.Table{}
-		t.AddColumn("NAME", func(i *fi.KeystoreItem) string {
+		t.AddColumn("NAME", func(i *secretInfo) string {
 			return i.Name
 		})
-		t.AddColumn("ID", func(i *fi.KeystoreItem) string {
-			return i.Id
+		t.AddColumn("ID", func(i *secretInfo) string {
+			return i.ID
 		})
-		t.AddColumn("TYPE", func(i *fi.KeystoreItem) string {
+		t.AddColumn("TYPE", func(i *secretInfo) string {
 			return i.Type
 		})
-		return t.Render(items, os.Stdout, "TYPE", "NAME", "ID")
+		t.AddColumn("CREATED", func(i *secretInfo) string {
+			if i.NotBefore == nil {
+				return ""
+			}
+			return i.NotBefore.Format("2006-01-02")
+		})
+		t.AddColumn("USED BY", func(i *secretInfo) string {
+			return i.UsedBy
+		})
+		return t.Render(infos, os.Stdout, "TYPE", "NAME", "ID", "CREATED", "USED BY")
 
 	case OutputYaml:
-		return fmt.Errorf("yaml output format is not (currently) supported for secrets")
+		b, err := yaml.Marshal(buildSecretInfos(keyStore, items))
+		if err != nil {
+			return fmt.Errorf("error marshaling secrets: %v", err)
+		}
+		_, err = os.Stdout.Write(b)
+		return err
+
 	case OutputJSON:
-		return fmt.Errorf("json output format is not (currently) supported for secrets")
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(buildSecretInfos(keyStore, items))
+
 	case "plaintext":
 		for _, i := range items {
 			var data string
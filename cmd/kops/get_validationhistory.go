@@ -0,0 +1,193 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/pkg/validation"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	get_validationhistory_long = templates.LongDesc(i18n.T(`
+	Display the validation records saved in the state store by
+	kops validate cluster --save-history, oldest first.  Useful for
+	pinning down when a cluster started degrading.`))
+
+	get_validationhistory_example = templates.Examples(i18n.T(`
+	# List the saved validation results
+	kops get validation-history --name k8s-cluster.example.com
+
+	# Show what changed between consecutive validation results
+	kops get validation-history --name k8s-cluster.example.com --diff`))
+
+	get_validationhistory_short = i18n.T(`Display saved cluster validation results.`)
+)
+
+type GetValidationHistoryOptions struct {
+	*GetOptions
+
+	// Diff shows the failures that appeared or cleared between consecutive
+	// records instead of the summary table
+	Diff bool
+}
+
+func NewCmdGetValidationHistory(f *util.Factory, out io.Writer, getOptions *GetOptions) *cobra.Command {
+	options := GetValidationHistoryOptions{
+		GetOptions: getOptions,
+	}
+	cmd := &cobra.Command{
+		Use:     "validation-history",
+		Short:   get_validationhistory_short,
+		Long:    get_validationhistory_long,
+		Example: get_validationhistory_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunGetValidationHistory(&options, args, out)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Diff, "diff", options.Diff, "Show the failures that appeared or cleared between consecutive records")
+
+	return cmd
+}
+
+func RunGetValidationHistory(options *GetValidationHistoryOptions, args []string, out io.Writer) error {
+	err := rootCommand.ProcessArgs(args)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+
+	configBase, err := registry.ConfigBase(cluster)
+	if err != nil {
+		return err
+	}
+
+	records, err := validation.ReadValidationHistory(configBase)
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		fmt.Fprintf(os.Stderr, "No validation history found for cluster %q; save records with kops validate cluster --save-history\n", cluster.ObjectMeta.Name)
+		return nil
+	}
+
+	if options.Diff {
+		return diffValidationHistory(out, records)
+	}
+
+	switch options.output {
+	case OutputTable:
+		t := &tables.Table{}
+		t.AddColumn("TIMESTAMP", func(v *validation.ValidationCluster) string {
+			return v.Timestamp.UTC().Format(time.RFC3339)
+		})
+		t.AddColumn("MASTERS", func(v *validation.ValidationCluster) string {
+			return fmt.Sprintf("%d/%d", len(v.MastersReadyArray), v.MastersCount)
+		})
+		t.AddColumn("NODES", func(v *validation.ValidationCluster) string {
+			return fmt.Sprintf("%d/%d", len(v.NodesReadyArray), v.NodesCount)
+		})
+		t.AddColumn("FAILURES", func(v *validation.ValidationCluster) string {
+			return fmt.Sprintf("%d", len(v.Failures))
+		})
+		return t.Render(records, out, "TIMESTAMP", "MASTERS", "NODES", "FAILURES")
+
+	case OutputJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+
+	case OutputYaml:
+		b, err := yaml.Marshal(records)
+		if err != nil {
+			return fmt.Errorf("error marshaling validation history: %v", err)
+		}
+		_, err = out.Write(b)
+		return err
+
+	default:
+		return fmt.Errorf("Unknown output format: %q", options.output)
+	}
+}
+
+// diffValidationHistory prints, for each record after the first, the failures
+// that were not in the previous record (+) and the ones that cleared (-).
+// Records whose failures match their predecessor's are skipped.
+func diffValidationHistory(out io.Writer, records []*validation.ValidationCluster) error {
+	previous := failureSet(records[0])
+	for _, record := range records[1:] {
+		current := failureSet(record)
+
+		var added, removed []string
+		for f := range current {
+			if !previous[f] {
+				added = append(added, f)
+			}
+		}
+		for f := range previous {
+			if !current[f] {
+				removed = append(removed, f)
+			}
+		}
+		previous = current
+
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		sort.Strings(added)
+		sort.Strings(removed)
+
+		fmt.Fprintf(out, "%s:\n", record.Timestamp.UTC().Format(time.RFC3339))
+		for _, f := range added {
+			fmt.Fprintf(out, "  + %s\n", f)
+		}
+		for _, f := range removed {
+			fmt.Fprintf(out, "  - %s\n", f)
+		}
+	}
+	return nil
+}
+
+func failureSet(v *validation.ValidationCluster) map[string]bool {
+	set := make(map[string]bool)
+	for _, f := range v.Failures {
+		set[fmt.Sprintf("%s: %s", f.Code, f.Message)] = true
+	}
+	return set
+}
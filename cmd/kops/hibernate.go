@@ -0,0 +1,51 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	hibernate_long = templates.LongDesc(i18n.T(`
+	Hibernate a resource, retaining volumes and configuration.`))
+
+	hibernate_example = templates.Examples(i18n.T(`
+	# Scale the node instance groups of a cluster to zero
+	kops hibernate cluster --name k8s-cluster.example.com --yes
+	`))
+
+	hibernate_short = i18n.T(`Hibernate a resource.`)
+)
+
+func NewCmdHibernate(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hibernate",
+		Short:   hibernate_short,
+		Long:    hibernate_long,
+		Example: hibernate_example,
+	}
+
+	cmd.AddCommand(NewCmdHibernateCluster(f, out))
+
+	return cmd
+}
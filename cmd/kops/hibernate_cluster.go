@@ -0,0 +1,175 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	hibernate_cluster_long = templates.LongDesc(i18n.T(`
+	Scale the node instance groups of a cluster to zero to cut costs, retaining
+	volumes and cluster configuration.  The cluster spec in the state store is
+	not modified, so kops resume cluster (or kops update cluster) restores the
+	configured sizes.
+
+	With --masters the control-plane instance groups are scaled down as well;
+	the cluster state survives in the etcd volumes, but the API will be
+	unavailable until the cluster is resumed.`))
+
+	hibernate_cluster_example = templates.Examples(i18n.T(`
+	# Scale the node instance groups of a cluster to zero
+	kops hibernate cluster --name k8s-cluster.example.com --yes
+
+	# Also stop the control plane
+	kops hibernate cluster --name k8s-cluster.example.com --masters --yes
+	`))
+
+	hibernate_cluster_short = i18n.T(`Scale a cluster down to zero nodes`)
+)
+
+type HibernateClusterOptions struct {
+	Yes     bool
+	Masters bool
+
+	ClusterName string
+}
+
+func NewCmdHibernateCluster(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &HibernateClusterOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "cluster",
+		Short:   hibernate_cluster_short,
+		Long:    hibernate_cluster_long,
+		Example: hibernate_cluster_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			options.ClusterName = rootCommand.ClusterName()
+
+			err = RunHibernateCluster(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Scale the instance groups; without --yes only the planned changes are printed")
+	cmd.Flags().BoolVar(&options.Masters, "masters", options.Masters, "Also scale down the master instance groups")
+
+	return cmd
+}
+
+func RunHibernateCluster(f *util.Factory, out io.Writer, options *HibernateClusterOptions) error {
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(options.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	list, err := clientset.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return fmt.Errorf("hibernate is not supported on %q", cluster.Spec.CloudProvider)
+	}
+
+	modelContext := &model.KopsModelContext{Cluster: cluster}
+
+	// Scale down nodes before masters, so pods are torn down while the API is still up
+	var groups []*api.InstanceGroup
+	var masters []*api.InstanceGroup
+	for i := range list.Items {
+		ig := &list.Items[i]
+		if ig.IsMaster() {
+			masters = append(masters, ig)
+		} else {
+			groups = append(groups, ig)
+		}
+	}
+	if options.Masters {
+		groups = append(groups, masters...)
+	}
+
+	for _, ig := range groups {
+		asgName := modelContext.AutoscalingGroupName(ig)
+		fmt.Fprintf(out, "Will scale autoscaling group %q to 0\n", asgName)
+	}
+
+	if !options.Yes {
+		fmt.Fprintf(out, "\nMust specify --yes to hibernate the cluster\n")
+		return nil
+	}
+
+	if err := rootCommand.AssumeRoleForMutation(); err != nil {
+		return err
+	}
+
+	for _, ig := range groups {
+		asgName := modelContext.AutoscalingGroupName(ig)
+		request := &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(asgName),
+			MinSize:              aws.Int64(0),
+			MaxSize:              aws.Int64(0),
+			DesiredCapacity:      aws.Int64(0),
+		}
+		_, err = awsCloud.Autoscaling().UpdateAutoScalingGroup(request)
+		if err != nil {
+			return withExitCode(ExitCodePartialSuccess, fmt.Errorf("error scaling AutoScalingGroup %q to 0: %v", asgName, err))
+		}
+		fmt.Fprintf(out, "Scaled autoscaling group %q to 0\n", asgName)
+	}
+
+	fmt.Fprintf(out, "\nCluster %q hibernated; use kops resume cluster to restore the configured sizes\n", cluster.ObjectMeta.Name)
+	return nil
+}
@@ -19,15 +19,60 @@ package main // import "k8s.io/kops/cmd/kops"
 import (
 	"fmt"
 	"os"
+
+	"k8s.io/kops/pkg/errorcatalog"
 )
 
 func main() {
 	Execute()
 }
 
+// Exit codes, so that automation can branch on the class of failure
+// instead of parsing stderr.  1 remains the catch-all for unclassified errors.
+const (
+	// ExitCodeGeneralError is an unclassified error
+	ExitCodeGeneralError = 1
+	// ExitCodeConfigError means the cluster spec / command arguments were invalid
+	ExitCodeConfigError = 2
+	// ExitCodeCloudError means a cloud provider call failed (often transient)
+	ExitCodeCloudError = 3
+	// ExitCodeValidationFailed means the cluster did not validate
+	ExitCodeValidationFailed = 4
+	// ExitCodePartialSuccess means some of the requested changes were applied before a failure
+	ExitCodePartialSuccess = 5
+)
+
+// exitCodeError associates an exit code with an underlying error
+type exitCodeError struct {
+	err  error
+	code int
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+// withExitCode wraps err so that exitWithError exits with the given code.
+// A nil err is passed through unchanged.
+func withExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeError{err: err, code: code}
+}
+
 // exitWithError will terminate execution with an error result
 // It prints the error to stderr and exits with a non-zero exit code
 func exitWithError(err error) {
 	fmt.Fprintf(os.Stderr, "\n%v\n", err)
-	os.Exit(1)
+	if entry := errorcatalog.Lookup(err); entry != nil {
+		fmt.Fprintf(os.Stderr, "\n[%s] %s\n%s\n", entry.Code, entry.Summary, entry.Remediation)
+		if entry.DocsLink != "" {
+			fmt.Fprintf(os.Stderr, "See: %s\n", entry.DocsLink)
+		}
+	}
+	if exitCodeErr, ok := err.(*exitCodeError); ok {
+		os.Exit(exitCodeErr.code)
+	}
+	os.Exit(ExitCodeGeneralError)
 }
@@ -0,0 +1,187 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	resume_cluster_long = templates.LongDesc(i18n.T(`
+	Restore the instance group sizes of a cluster hibernated with
+	kops hibernate cluster, scaling the cloud autoscaling groups back to the
+	sizes configured in the cluster spec.  Masters are scaled up before nodes,
+	so the API is available by the time workloads are rescheduled.`))
+
+	resume_cluster_example = templates.Examples(i18n.T(`
+	# Restore the configured instance group sizes
+	kops resume cluster --name k8s-cluster.example.com --yes
+	`))
+
+	resume_cluster_short = i18n.T(`Restore the configured sizes of a hibernated cluster`)
+)
+
+type ResumeClusterOptions struct {
+	Yes bool
+
+	ClusterName string
+}
+
+func NewCmdResumeCluster(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ResumeClusterOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "cluster",
+		Short:   resume_cluster_short,
+		Long:    resume_cluster_long,
+		Example: resume_cluster_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			options.ClusterName = rootCommand.ClusterName()
+
+			err = RunResumeCluster(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Scale the instance groups; without --yes only the planned changes are printed")
+
+	return cmd
+}
+
+func RunResumeCluster(f *util.Factory, out io.Writer, options *ResumeClusterOptions) error {
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(options.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	list, err := clientset.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return fmt.Errorf("resume is not supported on %q", cluster.Spec.CloudProvider)
+	}
+
+	modelContext := &model.KopsModelContext{Cluster: cluster}
+
+	// Scale up masters before nodes, so the API is available when nodes register
+	var groups []*api.InstanceGroup
+	for i := range list.Items {
+		ig := &list.Items[i]
+		if ig.IsMaster() {
+			groups = append(groups, ig)
+		}
+	}
+	for i := range list.Items {
+		ig := &list.Items[i]
+		if !ig.IsMaster() {
+			groups = append(groups, ig)
+		}
+	}
+
+	for _, ig := range groups {
+		minSize, maxSize := instanceGroupSizeBounds(ig)
+		fmt.Fprintf(out, "Will scale autoscaling group %q to %d-%d\n", modelContext.AutoscalingGroupName(ig), minSize, maxSize)
+	}
+
+	if !options.Yes {
+		fmt.Fprintf(out, "\nMust specify --yes to resume the cluster\n")
+		return nil
+	}
+
+	if err := rootCommand.AssumeRoleForMutation(); err != nil {
+		return err
+	}
+
+	for _, ig := range groups {
+		asgName := modelContext.AutoscalingGroupName(ig)
+		minSize, maxSize := instanceGroupSizeBounds(ig)
+
+		request := &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(asgName),
+			MinSize:              aws.Int64(int64(minSize)),
+			MaxSize:              aws.Int64(int64(maxSize)),
+			DesiredCapacity:      aws.Int64(int64(minSize)),
+		}
+		_, err = awsCloud.Autoscaling().UpdateAutoScalingGroup(request)
+		if err != nil {
+			return withExitCode(ExitCodePartialSuccess, fmt.Errorf("error scaling AutoScalingGroup %q: %v", asgName, err))
+		}
+		fmt.Fprintf(out, "Scaled autoscaling group %q to %d-%d\n", asgName, minSize, maxSize)
+	}
+
+	fmt.Fprintf(out, "\nCluster %q resumed; run kops validate cluster to check health\n", cluster.ObjectMeta.Name)
+	return nil
+}
+
+// instanceGroupSizeBounds returns the configured size range of the instance group,
+// applying the same defaults as the cloudup models
+func instanceGroupSizeBounds(ig *api.InstanceGroup) (int32, int32) {
+	minSize := int32(1)
+	maxSize := int32(1)
+	if ig.Spec.MinSize != nil {
+		minSize = fi.Int32Value(ig.Spec.MinSize)
+	} else if ig.Spec.Role == api.InstanceGroupRoleNode {
+		minSize = 2
+	}
+	if ig.Spec.MaxSize != nil {
+		maxSize = fi.Int32Value(ig.Spec.MaxSize)
+	} else if ig.Spec.Role == api.InstanceGroupRoleNode {
+		maxSize = 2
+	}
+	return minSize, maxSize
+}
@@ -33,6 +33,8 @@ import (
 	api "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/pkg/instancegroups"
+	"k8s.io/kops/pkg/validation"
+	"k8s.io/kops/pkg/verification"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/upup/pkg/kutil"
 	"k8s.io/kops/util/pkg/tables"
@@ -85,6 +87,18 @@ type RollingUpdateOptions struct {
 	Force     bool
 	CloudOnly bool
 
+	// ByZone rolls nodes one availability zone at a time,
+	// completing a zone before starting the next
+	ByZone bool
+
+	// MaxConcurrentInstanceGroups is the number of node instance groups
+	// to roll at the same time
+	MaxConcurrentInstanceGroups int
+
+	// ChangedOnly skips instances that already run their group's current
+	// configuration, even when --force is set
+	ChangedOnly bool
+
 	// The following two variables are when kops is validating a cluster
 	// during a rolling update.
 
@@ -97,6 +111,22 @@ type RollingUpdateOptions struct {
 
 	DrainInterval time.Duration
 
+	// HoldAnnotation is the node annotation that excludes a node from the
+	// rolling update, so a node under investigation can be pinned
+	HoldAnnotation string
+
+	// PodNamespaces are extra namespaces whose pods gate validation between
+	// instance replacements, in addition to kube-system
+	PodNamespaces []string
+
+	// PodSelector restricts which pods in PodNamespaces gate validation
+	PodSelector string
+
+	// IgnorePodSelectors excludes pods matching these label selectors from
+	// the validation gate, so a known-broken addon cannot block an
+	// emergency roll
+	IgnorePodSelectors []string
+
 	ValidateRetries int
 
 	MasterInterval  time.Duration
@@ -114,6 +144,9 @@ func (o *RollingUpdateOptions) InitDefaults() {
 	o.Yes = false
 	o.Force = false
 	o.CloudOnly = false
+	o.ByZone = false
+	o.MaxConcurrentInstanceGroups = 1
+	o.ChangedOnly = false
 	o.FailOnDrainError = false
 	o.FailOnValidate = true
 
@@ -125,6 +158,8 @@ func (o *RollingUpdateOptions) InitDefaults() {
 
 	o.DrainInterval = 90 * time.Second
 
+	o.HoldAnnotation = "kops.k8s.io/hold"
+
 }
 
 func NewCmdRollingUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
@@ -142,6 +177,13 @@ func NewCmdRollingUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().BoolVar(&options.Yes, "yes", options.Yes, "perform rolling update without confirmation")
 	cmd.Flags().BoolVar(&options.Force, "force", options.Force, "Force rolling update, even if no changes")
 	cmd.Flags().BoolVar(&options.CloudOnly, "cloudonly", options.CloudOnly, "Perform rolling update without confirming progress with k8s")
+	cmd.Flags().BoolVar(&options.ByZone, "by-zone", options.ByZone, "Roll nodes zone by zone, completing one availability zone before starting the next")
+	cmd.Flags().StringVar(&options.HoldAnnotation, "hold-annotation", options.HoldAnnotation, "Node annotation that excludes a node from the rolling update")
+	cmd.Flags().StringSliceVar(&options.PodNamespaces, "pod-namespace", options.PodNamespaces, "Additional namespaces whose pods must be healthy when validating between instance replacements")
+	cmd.Flags().StringVar(&options.PodSelector, "pod-selector", options.PodSelector, "Label selector restricting which pods in the --pod-namespace namespaces gate validation")
+	cmd.Flags().StringSliceVar(&options.IgnorePodSelectors, "ignore-pod-selector", options.IgnorePodSelectors, "Label selectors for unhealthy pods to exclude from the validation gate (exclusions are logged)")
+	cmd.Flags().IntVar(&options.MaxConcurrentInstanceGroups, "max-concurrent-instance-groups", options.MaxConcurrentInstanceGroups, "Number of node instance groups to roll in parallel (masters and bastions are unaffected)")
+	cmd.Flags().BoolVar(&options.ChangedOnly, "changed-only", options.ChangedOnly, "Only replace instances whose configuration differs from the instance group's current configuration, even with --force")
 
 	cmd.Flags().DurationVar(&options.MasterInterval, "master-interval", options.MasterInterval, "Time to wait between restarting masters")
 	cmd.Flags().DurationVar(&options.NodeInterval, "node-interval", options.NodeInterval, "Time to wait between restarting nodes")
@@ -190,7 +232,7 @@ func RunRollingUpdateCluster(f *util.Factory, out io.Writer, options *RollingUpd
 
 	cluster, err := GetCluster(f, options.ClusterName)
 	if err != nil {
-		return err
+		return withExitCode(ExitCodeConfigError, err)
 	}
 
 	contextName := cluster.ObjectMeta.Name
@@ -205,6 +247,24 @@ func RunRollingUpdateCluster(f *util.Factory, out io.Writer, options *RollingUpd
 		return fmt.Errorf("validate-retries flag cannot be 0 or smaller")
 	}
 
+	if options.PodSelector != "" && len(options.PodNamespaces) == 0 {
+		return fmt.Errorf("--pod-selector requires --pod-namespace")
+	}
+	for _, namespace := range options.PodNamespaces {
+		validation.RegisterPodValidationScope(validation.PodValidationScope{Namespace: namespace, Selector: options.PodSelector})
+	}
+	for _, selector := range options.IgnorePodSelectors {
+		if err := validation.RegisterPodIgnoreSelector(selector); err != nil {
+			return err
+		}
+	}
+
+	// Metric gates from the cluster spec join the validation run between
+	// instance replacements
+	if cluster.Spec.Validation != nil && len(cluster.Spec.Validation.MetricGates) != 0 {
+		validation.RegisterValidationCheck(&validation.MetricGatesCheck{Gates: cluster.Spec.Validation.MetricGates})
+	}
+
 	var nodes []v1.Node
 	var k8sClient kubernetes.Interface
 	if !options.CloudOnly {
@@ -268,7 +328,7 @@ func RunRollingUpdateCluster(f *util.Factory, out io.Writer, options *RollingUpd
 
 	groups, err := instancegroups.FindCloudInstanceGroups(cloud, cluster, instanceGroups, warnUnmatched, nodes)
 	if err != nil {
-		return err
+		return withExitCode(ExitCodeCloudError, err)
 	}
 
 	{
@@ -327,7 +387,7 @@ func RunRollingUpdateCluster(f *util.Factory, out io.Writer, options *RollingUpd
 		}
 	}
 
-	if !needUpdate && !options.Force {
+	if !needUpdate && (!options.Force || options.ChangedOnly) {
 		fmt.Printf("\nNo rolling-update required.\n")
 		return nil
 	}
@@ -337,22 +397,81 @@ func RunRollingUpdateCluster(f *util.Factory, out io.Writer, options *RollingUpd
 		return nil
 	}
 
+	if err := rootCommand.AssumeRoleForMutation(); err != nil {
+		return err
+	}
+
 	if featureflag.DrainAndValidateRollingUpdate.Enabled() {
 		glog.V(2).Infof("New rolling update with drain and validate enabled.")
 	}
 	d := &instancegroups.RollingUpdateCluster{
-		MasterInterval:   options.MasterInterval,
-		NodeInterval:     options.NodeInterval,
-		Force:            options.Force,
-		Cloud:            cloud,
-		K8sClient:        k8sClient,
-		ClientConfig:     kutil.NewClientConfig(config, "kube-system"),
-		FailOnDrainError: options.FailOnDrainError,
-		FailOnValidate:   options.FailOnValidate,
-		CloudOnly:        options.CloudOnly,
-		ClusterName:      options.ClusterName,
-		ValidateRetries:  options.ValidateRetries,
-		DrainInterval:    options.DrainInterval,
+		MasterInterval:      options.MasterInterval,
+		NodeInterval:        options.NodeInterval,
+		Force:               options.Force,
+		Cloud:               cloud,
+		K8sClient:           k8sClient,
+		ClientConfig:        kutil.NewClientConfig(config, "kube-system"),
+		FailOnDrainError:    options.FailOnDrainError,
+		FailOnValidate:      options.FailOnValidate,
+		CloudOnly:           options.CloudOnly,
+		ByZone:              options.ByZone,
+		HoldAnnotation:      options.HoldAnnotation,
+		MaxConcurrentGroups: options.MaxConcurrentInstanceGroups,
+		ChangedOnly:         options.ChangedOnly,
+		ClusterName:         options.ClusterName,
+		ValidateRetries:     options.ValidateRetries,
+		DrainInterval:       options.DrainInterval,
+	}
+	// A failure mid-roll means some instances have been replaced and some have not
+	if err := d.RollingUpdate(groups, list); err != nil {
+		return withExitCode(ExitCodePartialSuccess, err)
 	}
-	return d.RollingUpdate(groups, list)
+
+	if len(cluster.Spec.RollingUpdateVerification) != 0 {
+		if options.CloudOnly {
+			fmt.Fprintf(out, "\nSkipping post-rolling-update verification checks (--cloudonly)\n")
+			return nil
+		}
+		return runRollingUpdateVerification(cluster, k8sClient, out)
+	}
+
+	return nil
+}
+
+// runRollingUpdateVerification runs the checks from the cluster spec and
+// renders the report; a failed check makes the rolling update fail.
+func runRollingUpdateVerification(cluster *api.Cluster, k8sClient kubernetes.Interface, out io.Writer) error {
+	fmt.Fprintf(out, "\nRunning post-rolling-update verification checks\n\n")
+
+	report := verification.RunChecks(cluster.Spec.RollingUpdateVerification, k8sClient)
+
+	t := &tables.Table{}
+	t.AddColumn("NAME", func(r verification.CheckResult) string {
+		return r.Name
+	})
+	t.AddColumn("TYPE", func(r verification.CheckResult) string {
+		return r.Type
+	})
+	t.AddColumn("RESULT", func(r verification.CheckResult) string {
+		if r.Passed {
+			return "passed"
+		}
+		return "FAILED"
+	})
+	t.AddColumn("DURATION", func(r verification.CheckResult) string {
+		return r.Duration.String()
+	})
+	t.AddColumn("MESSAGE", func(r verification.CheckResult) string {
+		return r.Message
+	})
+	if err := t.Render(report.Results, out, "NAME", "TYPE", "RESULT", "DURATION", "MESSAGE"); err != nil {
+		return err
+	}
+
+	if failed := report.Failed(); len(failed) != 0 {
+		return fmt.Errorf("%d of %d verification checks failed", len(failed), len(report.Results))
+	}
+
+	fmt.Fprintf(out, "\nAll verification checks passed\n")
+	return nil
 }
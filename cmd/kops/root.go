@@ -31,6 +31,8 @@ import (
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/readonly"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/kutil"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
 	"k8s.io/kubernetes/pkg/util/i18n"
@@ -79,6 +81,13 @@ type RootCmd struct {
 
 	clusterName string
 
+	readOnly bool
+
+	assumeRoleARN        string
+	assumeRoleExternalID string
+	assumeRoleMFASerial  string
+	assumeRoleMFAToken   string
+
 	cobraCommand *cobra.Command
 }
 
@@ -89,6 +98,7 @@ var rootCommand = RootCmd{
 		Use:   "kops",
 		Short: root_short,
 		Long:  root_long,
+		BashCompletionFunction: bashCompletionFunc,
 	},
 }
 
@@ -134,16 +144,29 @@ func NewCmdRoot(f *util.Factory, out io.Writer) *cobra.Command {
 
 	cmd.PersistentFlags().StringVarP(&rootCommand.clusterName, "name", "", "", "Name of cluster")
 
+	cmd.PersistentFlags().BoolVar(&rootCommand.readOnly, "read-only", readonly.Enabled(), "Reject all cloud and state store mutations (can also be set with KOPS_READ_ONLY)")
+
+	cmd.PersistentFlags().StringVar(&rootCommand.assumeRoleARN, "assume-role", "", "ARN of an elevated role to assume for mutating cloud operations (reads use the base credentials)")
+	cmd.PersistentFlags().StringVar(&rootCommand.assumeRoleExternalID, "assume-role-external-id", "", "External id to pass when assuming the role given with --assume-role")
+	cmd.PersistentFlags().StringVar(&rootCommand.assumeRoleMFASerial, "assume-role-mfa-serial", "", "Serial number / ARN of the MFA device required to assume the role")
+	cmd.PersistentFlags().StringVar(&rootCommand.assumeRoleMFAToken, "mfa-token", "", "Current one-time code from the MFA device")
+
 	// create subcommands
 	cmd.AddCommand(NewCmdCompletion(f, out))
+	cmd.AddCommand(NewCmdCompletionNames(f, out))
+	cmd.AddCommand(NewCmdClone(f, out))
 	cmd.AddCommand(NewCmdCreate(f, out))
 	cmd.AddCommand(NewCmdDelete(f, out))
 	cmd.AddCommand(NewCmdEdit(f, out))
 	cmd.AddCommand(NewCmdExport(f, out))
 	cmd.AddCommand(NewCmdGet(f, out))
+	cmd.AddCommand(NewCmdHibernate(f, out))
 	cmd.AddCommand(NewCmdUpdate(f, out))
 	cmd.AddCommand(NewCmdReplace(f, out))
+	cmd.AddCommand(NewCmdResume(f, out))
 	cmd.AddCommand(NewCmdRollingUpdate(f, out))
+	cmd.AddCommand(NewCmdRotate(f, out))
+	cmd.AddCommand(NewCmdScale(f, out))
 	cmd.AddCommand(NewCmdToolbox(f, out))
 	cmd.AddCommand(NewCmdValidate(f, out))
 
@@ -152,6 +175,10 @@ func NewCmdRoot(f *util.Factory, out io.Writer) *cobra.Command {
 
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
+	if rootCommand.readOnly {
+		readonly.Enable()
+	}
+
 	if rootCommand.configFile != "" {
 		// enable ability to specify config file via flag
 		viper.SetConfigFile(rootCommand.configFile)
@@ -276,6 +303,30 @@ func (c *RootCmd) Cluster() (*kopsapi.Cluster, error) {
 	return GetCluster(c.factory, clusterName)
 }
 
+// AssumeRoleForMutation switches AWS clients to the elevated role, if one was
+// requested with --assume-role.  Mutating commands call this before their first
+// cloud change, so read-only operations keep the base credentials.
+func (c *RootCmd) AssumeRoleForMutation() error {
+	if c.assumeRoleARN == "" {
+		if c.assumeRoleExternalID != "" || c.assumeRoleMFASerial != "" {
+			return fmt.Errorf("--assume-role is required when specifying assume-role options")
+		}
+		return nil
+	}
+
+	if c.assumeRoleMFASerial != "" && c.assumeRoleMFAToken == "" {
+		return fmt.Errorf("--mfa-token is required when --assume-role-mfa-serial is set")
+	}
+
+	awsup.ConfigureAssumeRole(&awsup.AssumeRoleOptions{
+		RoleARN:    c.assumeRoleARN,
+		ExternalID: c.assumeRoleExternalID,
+		MFASerial:  c.assumeRoleMFASerial,
+		MFAToken:   c.assumeRoleMFAToken,
+	})
+	return nil
+}
+
 func GetCluster(factory *util.Factory, clusterName string) (*kopsapi.Cluster, error) {
 	if clusterName == "" {
 		return nil, field.Required(field.NewPath("ClusterName"), "Cluster name is required")
@@ -0,0 +1,51 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	rotate_long = templates.LongDesc(i18n.T(`
+	Rotate cluster credentials.`))
+
+	rotate_example = templates.Examples(i18n.T(`
+	# Issue a new service account signing keypair
+	kops rotate service-account-key --name k8s-cluster.example.com --yes
+	`))
+
+	rotate_short = i18n.T(`Rotate cluster credentials.`)
+)
+
+func NewCmdRotate(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rotate",
+		Short:   rotate_short,
+		Long:    rotate_long,
+		Example: rotate_example,
+	}
+
+	cmd.AddCommand(NewCmdRotateServiceAccountKey(f, out))
+
+	return cmd
+}
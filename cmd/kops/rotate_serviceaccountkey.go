@@ -0,0 +1,292 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	rotate_serviceaccountkey_long = templates.LongDesc(i18n.T(`
+	Rotate the keypair that signs service account tokens.
+
+	In a kops cluster the kube-controller-manager signs service account tokens
+	with the master keypair (server.key), and the kube-apiserver verifies them
+	with the same key.  This command issues a new keypair into the keystore;
+	the previous keypair stays in the keystore pool until it is deleted, so
+	the old key can be retired deliberately once every token has been
+	re-issued.
+
+	Rotation is a multi-step workflow, and the command prints the remaining
+	steps after issuing the key: push the new keypair to the masters with kops
+	update cluster and a rolling update (masters restart before nodes, so the
+	signer and verifier change together), re-mint the service account tokens
+	signed by the old key, and finally delete the old keypair.`))
+
+	rotate_serviceaccountkey_example = templates.Examples(i18n.T(`
+	# Issue a new service account signing keypair
+	kops rotate service-account-key --name k8s-cluster.example.com --yes
+
+	# After the masters have been rolled, re-mint tokens signed by the old key
+	kops rotate service-account-key --name k8s-cluster.example.com --delete-stale-tokens --yes
+
+	# Republish the current verifying keys to a public bucket for external verifiers
+	kops rotate service-account-key --name k8s-cluster.example.com --publish-keys s3://example-keys/k8s-cluster.example.com
+	`))
+
+	rotate_serviceaccountkey_short = i18n.T(`Rotate the service account signing keypair`)
+)
+
+// serviceAccountSigningKeypair is the keystore id of the keypair that signs
+// service account tokens; kube-controller-manager points
+// --service-account-private-key-file at the master server.key
+const serviceAccountSigningKeypair = "master"
+
+type RotateServiceAccountKeyOptions struct {
+	Yes bool
+
+	// DeleteStaleTokens deletes the service account token secrets in the
+	// cluster instead of issuing a key, so the controller manager re-mints
+	// them with the current signing key; run it after the masters have been
+	// rolled onto the new keypair
+	DeleteStaleTokens bool
+
+	// PublishKeys is a vfs path (s3://, gs://, file://...) where the
+	// verifying public certificates are written, so external token
+	// verifiers can fetch the current keys.  During a rotation the pool
+	// holds both the old and the new key, so verifiers accept tokens
+	// signed by either until the old key is deleted.
+	PublishKeys string
+
+	ClusterName string
+}
+
+func NewCmdRotateServiceAccountKey(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &RotateServiceAccountKeyOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "service-account-key",
+		Short:   rotate_serviceaccountkey_short,
+		Long:    rotate_serviceaccountkey_long,
+		Example: rotate_serviceaccountkey_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			options.ClusterName = rootCommand.ClusterName()
+
+			err = RunRotateServiceAccountKey(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Rotate the keypair; without --yes only the planned changes are printed")
+	cmd.Flags().BoolVar(&options.DeleteStaleTokens, "delete-stale-tokens", options.DeleteStaleTokens, "Delete the cluster's service account token secrets so they are re-minted with the current signing key; run after the masters have been rolled")
+	cmd.Flags().StringVar(&options.PublishKeys, "publish-keys", options.PublishKeys, "Also write the verifying public certificates to this vfs path (s3://, gs://, ...) for external token verifiers; can be used without --yes to republish the current keys")
+
+	return cmd
+}
+
+func RunRotateServiceAccountKey(f *util.Factory, out io.Writer, options *RotateServiceAccountKeyOptions) error {
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	cluster, err := GetCluster(f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if options.DeleteStaleTokens {
+		return deleteStaleServiceAccountTokens(out, cluster.ObjectMeta.Name, options.Yes)
+	}
+
+	keyStore, err := registry.KeyStore(cluster)
+	if err != nil {
+		return err
+	}
+
+	cert, err := keyStore.FindCert(serviceAccountSigningKeypair)
+	if err != nil {
+		return err
+	}
+	if cert == nil || cert.Certificate == nil {
+		return fmt.Errorf("keypair %q not found in the keystore; has the cluster been created?", serviceAccountSigningKeypair)
+	}
+
+	// The old keypairs stay in the pool until deleted; name them so the
+	// operator can retire them once rotation is complete
+	items, err := keyStore.List()
+	if err != nil {
+		return err
+	}
+	var oldIds []string
+	for _, item := range items {
+		if item.Type == fi.SecretTypeKeypair && item.Name == serviceAccountSigningKeypair {
+			oldIds = append(oldIds, item.Id)
+		}
+	}
+
+	fmt.Fprintf(out, "Will issue a new keypair %q; the current keypair(s) %v remain in the keystore until deleted\n", serviceAccountSigningKeypair, oldIds)
+
+	if !options.Yes {
+		if options.PublishKeys != "" {
+			// Republishing the current keys is safe without confirmation
+			return publishServiceAccountKeys(out, keyStore, options.PublishKeys)
+		}
+		fmt.Fprintf(out, "\nMust specify --yes to rotate the service account signing keypair\n")
+		return nil
+	}
+
+	privateKey, err := fi.GeneratePrivateKey()
+	if err != nil {
+		return err
+	}
+
+	// Re-issue with the current certificate's identity; only the key changes
+	template := &x509.Certificate{
+		Subject:               cert.Certificate.Subject,
+		DNSNames:              cert.Certificate.DNSNames,
+		IPAddresses:           cert.Certificate.IPAddresses,
+		KeyUsage:              cert.Certificate.KeyUsage,
+		ExtKeyUsage:           cert.Certificate.ExtKeyUsage,
+		BasicConstraintsValid: true,
+	}
+
+	newCert, err := keyStore.CreateKeypair(serviceAccountSigningKeypair, template, privateKey)
+	if err != nil {
+		return fmt.Errorf("error issuing keypair: %v", err)
+	}
+
+	fmt.Fprintf(out, "Issued new keypair %q with serial %s\n", serviceAccountSigningKeypair, newCert.Certificate.SerialNumber)
+
+	if options.PublishKeys != "" {
+		// The pool now holds the old and the new key, so external
+		// verifiers accept tokens signed by either during the rotation
+		if err := publishServiceAccountKeys(out, keyStore, options.PublishKeys); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "\nTo complete the rotation:\n")
+	fmt.Fprintf(out, "  1. kops update cluster %s --yes\n", cluster.ObjectMeta.Name)
+	fmt.Fprintf(out, "  2. kops rolling-update cluster %s --force --yes   # masters restart first, switching signer and verifier together\n", cluster.ObjectMeta.Name)
+	fmt.Fprintf(out, "  3. kops rotate service-account-key --name %s --delete-stale-tokens --yes   # re-mint tokens signed by the old key, then restart pods using them\n", cluster.ObjectMeta.Name)
+	fmt.Fprintf(out, "  4. kops delete secret keypair %s <id> --name %s --force   # retire the old key(s) %v once all tokens are re-issued\n", serviceAccountSigningKeypair, cluster.ObjectMeta.Name, oldIds)
+
+	return nil
+}
+
+// publishServiceAccountKeys writes the current verifying certificate pool to
+// the vfs location and reads it back, so a half-written or stale object is
+// caught now instead of when token verification starts failing.  Any vfs
+// backend works (S3, GCS, a local path), so the keys can be served from
+// whichever public bucket the external verifiers read.
+func publishServiceAccountKeys(out io.Writer, keyStore fi.CAStore, location string) error {
+	pool, err := keyStore.CertificatePool(serviceAccountSigningKeypair)
+	if err != nil {
+		return err
+	}
+	if pool == nil || pool.Primary == nil {
+		return fmt.Errorf("keypair %q not found in the keystore", serviceAccountSigningKeypair)
+	}
+
+	serialized, err := pool.AsString()
+	if err != nil {
+		return err
+	}
+
+	base, err := vfs.Context.BuildVfsPath(location)
+	if err != nil {
+		return fmt.Errorf("error parsing location %q: %v", location, err)
+	}
+
+	p := base.Join("keys.pem")
+	if err := p.WriteFile([]byte(serialized)); err != nil {
+		return fmt.Errorf("error writing %s: %v", p.Path(), err)
+	}
+
+	published, err := p.ReadFile()
+	if err != nil {
+		return fmt.Errorf("error reading back %s: %v", p.Path(), err)
+	}
+	if string(published) != serialized {
+		return fmt.Errorf("%s does not serve the current keys after publication", p.Path())
+	}
+
+	fmt.Fprintf(out, "Published %d verifying certificate(s) to %s\n", 1+len(pool.Secondary), p.Path())
+	return nil
+}
+
+// deleteStaleServiceAccountTokens deletes every service account token secret
+// in the cluster; the controller manager immediately re-mints them with the
+// current signing key.  Pods keep the old token mounted until they restart.
+func deleteStaleServiceAccountTokens(out io.Writer, contextName string, yes bool) error {
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{CurrentContext: contextName}).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("Cannot load kubecfg settings for %q: %v", contextName, err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("Cannot build kube api client for %q: %v", contextName, err)
+	}
+
+	secrets, err := k8sClient.CoreV1().Secrets("").List(metav1.ListOptions{
+		FieldSelector: "type=kubernetes.io/service-account-token",
+	})
+	if err != nil {
+		return fmt.Errorf("error listing service account token secrets: %v", err)
+	}
+
+	fmt.Fprintf(out, "Will delete %d service account token secret(s); the controller manager re-mints them with the current signing key\n", len(secrets.Items))
+
+	if !yes {
+		fmt.Fprintf(out, "\nMust specify --yes to delete the stale tokens\n")
+		return nil
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		err := k8sClient.CoreV1().Secrets(secret.Namespace).Delete(secret.Name, &metav1.DeleteOptions{})
+		if err != nil {
+			return withExitCode(ExitCodePartialSuccess, fmt.Errorf("error deleting secret %s/%s: %v", secret.Namespace, secret.Name, err))
+		}
+	}
+
+	fmt.Fprintf(out, "Deleted %d secret(s); restart pods so they pick up the re-minted tokens\n", len(secrets.Items))
+	return nil
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	scale_ig_long = templates.LongDesc(i18n.T(`
+	Set the size of an instance group and immediately reconcile the cloud
+	autoscaling group, without running a full update cluster.
+
+	--replicas sets minSize and maxSize to the same value, mirroring
+	kubectl scale; --min and --max set the bounds individually.`))
+
+	scale_ig_example = templates.Examples(i18n.T(`
+	# Scale the nodes instance group to 4 instances
+	kops scale ig nodes --name k8s-cluster.example.com --replicas 4 --yes
+
+	# Widen the autoscaling bounds
+	kops scale ig nodes --name k8s-cluster.example.com --min 2 --max 10 --yes
+	`))
+
+	scale_ig_short = i18n.T(`Scale an instance group`)
+)
+
+type ScaleInstanceGroupOptions struct {
+	Yes      bool
+	Replicas int32
+	MinSize  int32
+	MaxSize  int32
+
+	ClusterName string
+	GroupName   string
+
+	// set by flag parsing
+	replicasSet bool
+	minSet      bool
+	maxSet      bool
+}
+
+func NewCmdScaleInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ScaleInstanceGroupOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "instancegroup",
+		Aliases: []string{"instancegroups", "ig"},
+		Short:   scale_ig_short,
+		Long:    scale_ig_long,
+		Example: scale_ig_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				exitWithError(fmt.Errorf("Specify name of instance group to scale"))
+			}
+			if len(args) != 1 {
+				exitWithError(fmt.Errorf("Can only scale one instance group at a time!"))
+			}
+
+			options.GroupName = args[0]
+			options.ClusterName = rootCommand.ClusterName()
+
+			options.replicasSet = cmd.Flags().Changed("replicas")
+			options.minSet = cmd.Flags().Changed("min")
+			options.maxSet = cmd.Flags().Changed("max")
+
+			err := RunScaleInstanceGroup(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Apply the new size; without --yes only the planned change is printed")
+	cmd.Flags().Int32Var(&options.Replicas, "replicas", 0, "Set minSize and maxSize to this value")
+	cmd.Flags().Int32Var(&options.MinSize, "min", 0, "Set the minimum size of the instance group")
+	cmd.Flags().Int32Var(&options.MaxSize, "max", 0, "Set the maximum size of the instance group")
+
+	return cmd
+}
+
+func RunScaleInstanceGroup(f *util.Factory, out io.Writer, options *ScaleInstanceGroupOptions) error {
+	if options.GroupName == "" {
+		return fmt.Errorf("GroupName is required")
+	}
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	if !options.replicasSet && !options.minSet && !options.maxSet {
+		return fmt.Errorf("must specify --replicas, or --min / --max")
+	}
+	if options.replicasSet && (options.minSet || options.maxSet) {
+		return fmt.Errorf("cannot combine --replicas with --min / --max")
+	}
+
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(options.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	group, err := clientset.InstanceGroupsFor(cluster).Get(options.GroupName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("InstanceGroup %q not found", options.GroupName)
+	}
+
+	minSize := fi.Int32Value(group.Spec.MinSize)
+	maxSize := fi.Int32Value(group.Spec.MaxSize)
+
+	if options.replicasSet {
+		minSize = options.Replicas
+		maxSize = options.Replicas
+	}
+	if options.minSet {
+		minSize = options.MinSize
+	}
+	if options.maxSet {
+		maxSize = options.MaxSize
+	}
+
+	if minSize < 0 || maxSize < minSize {
+		return fmt.Errorf("invalid size range: min %d, max %d", minSize, maxSize)
+	}
+
+	fmt.Fprintf(out, "InstanceGroup %q: size %d-%d -> %d-%d\n",
+		group.ObjectMeta.Name,
+		fi.Int32Value(group.Spec.MinSize), fi.Int32Value(group.Spec.MaxSize),
+		minSize, maxSize)
+
+	if !options.Yes {
+		fmt.Fprintf(out, "\nMust specify --yes to scale the instance group\n")
+		return nil
+	}
+
+	if err := rootCommand.AssumeRoleForMutation(); err != nil {
+		return err
+	}
+
+	group.Spec.MinSize = fi.Int32(minSize)
+	group.Spec.MaxSize = fi.Int32(maxSize)
+
+	err = validation.CrossValidateInstanceGroup(group, cluster, true)
+	if err != nil {
+		return err
+	}
+
+	_, err = clientset.InstanceGroupsFor(cluster).Update(group)
+	if err != nil {
+		return fmt.Errorf("error updating InstanceGroup %q: %v", group.ObjectMeta.Name, err)
+	}
+
+	// Reconcile just the autoscaling group size, rather than running a full update
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		fmt.Fprintf(out, "Spec updated; run kops update cluster to apply the new size on %q\n", cluster.Spec.CloudProvider)
+		return nil
+	}
+
+	var asgName string
+	if group.Spec.Role == api.InstanceGroupRoleMaster {
+		asgName = group.ObjectMeta.Name + ".masters." + cluster.ObjectMeta.Name
+	} else {
+		asgName = group.ObjectMeta.Name + "." + cluster.ObjectMeta.Name
+	}
+
+	request := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(asgName),
+		MinSize:              aws.Int64(int64(minSize)),
+		MaxSize:              aws.Int64(int64(maxSize)),
+	}
+	_, err = awsCloud.Autoscaling().UpdateAutoScalingGroup(request)
+	if err != nil {
+		return fmt.Errorf("error resizing AutoScalingGroup %q: %v", asgName, err)
+	}
+
+	fmt.Fprintf(out, "Scaled instance group %q to %d-%d\n", group.ObjectMeta.Name, minSize, maxSize)
+	return nil
+}
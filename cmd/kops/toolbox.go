@@ -45,8 +45,22 @@ func NewCmdToolbox(f *util.Factory, out io.Writer) *cobra.Command {
 		Example: toolbox_example,
 	}
 
+	cmd.AddCommand(NewCmdToolboxAMIBuilder(f, out))
+	cmd.AddCommand(NewCmdToolboxBastion(f, out))
+	cmd.AddCommand(NewCmdToolboxConformance(f, out))
 	cmd.AddCommand(NewCmdToolboxConvertImported(f, out))
+	cmd.AddCommand(NewCmdToolboxConvertManifest(f, out))
+	cmd.AddCommand(NewCmdToolboxCostReport(f, out))
+	cmd.AddCommand(NewCmdToolboxDNSSetup(f, out))
 	cmd.AddCommand(NewCmdToolboxDump(f, out))
+	cmd.AddCommand(NewCmdToolboxExplainDefaults(f, out))
+	cmd.AddCommand(NewCmdToolboxGC(f, out))
+	cmd.AddCommand(NewCmdToolboxImportTerraform(f, out))
+	cmd.AddCommand(NewCmdToolboxInventory(f, out))
+	cmd.AddCommand(NewCmdToolboxLint(f, out))
+	cmd.AddCommand(NewCmdToolboxRetag(f, out))
+	cmd.AddCommand(NewCmdToolboxSmokeTest(f, out))
+	cmd.AddCommand(NewCmdToolboxTemplate(f, out))
 
 	return cmd
 }
@@ -0,0 +1,219 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_amibuilder_long = templates.LongDesc(i18n.T(`
+	Generate a Packer template that bakes nodeup and its assets for the cluster's
+	exact versions into an image, so nodes boot in seconds instead of downloading
+	assets on first start.  The template is written to stdout or --out; run it with
+	packer, then record the resulting image with --image --yes.`))
+
+	toolbox_amibuilder_example = templates.Examples(i18n.T(`
+	# Generate a packer template for the cluster
+	kops toolbox ami-builder --name k8s-cluster.example.com --out packer.json
+
+	# After running packer, set the image on all instance groups
+	kops toolbox ami-builder --name k8s-cluster.example.com --image ami-12345678 --yes
+	`))
+
+	toolbox_amibuilder_short = i18n.T(`Generate a Packer template baking nodeup into an image`)
+)
+
+type ToolboxAMIBuilderOptions struct {
+	Out         string
+	SourceImage string
+	Image       string
+	Yes         bool
+
+	ClusterName string
+}
+
+func NewCmdToolboxAMIBuilder(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxAMIBuilderOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "ami-builder",
+		Short:   toolbox_amibuilder_short,
+		Long:    toolbox_amibuilder_long,
+		Example: toolbox_amibuilder_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			options.ClusterName = rootCommand.ClusterName()
+
+			err = RunToolboxAMIBuilder(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Out, "out", options.Out, "Path to write the packer template (default stdout)")
+	cmd.Flags().StringVar(&options.SourceImage, "source-image", options.SourceImage, "Source image to build from (default: the image of the first instance group)")
+	cmd.Flags().StringVar(&options.Image, "image", options.Image, "Baked image to record on the instance groups (skips template generation)")
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Update the instance groups with --image")
+
+	return cmd
+}
+
+func RunToolboxAMIBuilder(f *util.Factory, out io.Writer, options *ToolboxAMIBuilderOptions) error {
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(options.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	if cluster.Spec.CloudProvider != "aws" {
+		return fmt.Errorf("ami-builder is only supported on aws, not %q", cluster.Spec.CloudProvider)
+	}
+
+	list, err := clientset.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	// With --image we just record the baked image on the instance groups
+	if options.Image != "" {
+		for i := range list.Items {
+			ig := &list.Items[i]
+			fmt.Fprintf(out, "InstanceGroup %q: image %q -> %q\n", ig.ObjectMeta.Name, ig.Spec.Image, options.Image)
+		}
+		if !options.Yes {
+			fmt.Fprintf(out, "\nMust specify --yes to update the instance groups\n")
+			return nil
+		}
+		for i := range list.Items {
+			ig := &list.Items[i]
+			ig.Spec.Image = options.Image
+			if _, err := clientset.InstanceGroupsFor(cluster).Update(ig); err != nil {
+				return fmt.Errorf("error updating InstanceGroup %q: %v", ig.ObjectMeta.Name, err)
+			}
+		}
+		fmt.Fprintf(out, "\nUpdated instance groups; run kops update cluster and kops rolling-update cluster to roll the new image\n")
+		return nil
+	}
+
+	region, err := awsup.FindRegion(cluster)
+	if err != nil {
+		return err
+	}
+
+	sourceImage := options.SourceImage
+	if sourceImage == "" && len(list.Items) != 0 {
+		sourceImage = list.Items[0].Spec.Image
+	}
+	if sourceImage == "" {
+		return fmt.Errorf("cannot determine source image; specify --source-image")
+	}
+
+	template := buildPackerTemplate(cluster.ObjectMeta.Name, region, sourceImage)
+
+	data, err := json.MarshalIndent(template, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling packer template: %v", err)
+	}
+	data = append(data, '\n')
+
+	if options.Out != "" {
+		if err := writeFile(options.Out, data); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Packer template written to %s\n", options.Out)
+		fmt.Fprintf(out, "Build the image with: packer build %s\n", options.Out)
+		fmt.Fprintf(out, "Then record it with: kops toolbox ami-builder --image <ami> --yes\n")
+		return nil
+	}
+
+	_, err = out.Write(data)
+	return err
+}
+
+// buildPackerTemplate renders an amazon-ebs packer template that pre-downloads
+// nodeup for this kops version, so booting nodes skip the download
+func buildPackerTemplate(clusterName string, region string, sourceImage string) map[string]interface{} {
+	nodeupURL := cloudup.NodeUpLocation()
+
+	provisionScript := []string{
+		"sudo mkdir -p /var/cache/kubernetes-install",
+		fmt.Sprintf("sudo curl -f --retry 5 -o /var/cache/kubernetes-install/nodeup %s", nodeupURL),
+		"sudo chmod +x /var/cache/kubernetes-install/nodeup",
+	}
+
+	return map[string]interface{}{
+		"builders": []map[string]interface{}{
+			{
+				"type":          "amazon-ebs",
+				"region":        region,
+				"source_ami":    sourceImage,
+				"instance_type": "m3.medium",
+				"ssh_username":  "admin",
+				"ami_name":      fmt.Sprintf("kops-%s-{{timestamp}}", clusterName),
+				"tags": map[string]string{
+					awsup.TagClusterName: clusterName,
+				},
+			},
+		},
+		"provisioners": []map[string]interface{}{
+			{
+				"type":   "shell",
+				"inline": provisionScript,
+			},
+		},
+	}
+}
+
+func writeFile(path string, data []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating file %q: %v", path, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("error writing file %q: %v", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,482 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_bastion_long = templates.LongDesc(i18n.T(`
+	Manage an on-demand bastion for a private-topology cluster.
+
+	Instead of keeping a bastion instance group running permanently, start launches a
+	single temporary bastion instance in a utility subnet when you need SSH access,
+	and stop tears it down again (including any security group start created).`))
+
+	toolbox_bastion_example = templates.Examples(i18n.T(`
+	# Launch a temporary bastion
+	kops toolbox bastion start --name k8s-cluster.example.com
+
+	# Tear it down when done
+	kops toolbox bastion stop --name k8s-cluster.example.com
+	`))
+
+	toolbox_bastion_short = i18n.T(`Manage an on-demand bastion instance.`)
+)
+
+// onDemandBastionName builds the Name tag for the temporary bastion and its security group
+func onDemandBastionName(clusterName string) string {
+	return "bastion.on-demand." + clusterName
+}
+
+type ToolboxBastionStartOptions struct {
+	// InstanceType is the EC2 instance type for the bastion
+	InstanceType string
+	// Image overrides the AMI; by default the image of the first instance group is used
+	Image string
+	// SSHAccess is the CIDR allowed to SSH to the bastion, if we create a security group
+	SSHAccess string
+}
+
+func (o *ToolboxBastionStartOptions) InitDefaults() {
+	o.InstanceType = "t2.micro"
+	o.SSHAccess = "0.0.0.0/0"
+}
+
+func NewCmdToolboxBastion(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bastion",
+		Short:   toolbox_bastion_short,
+		Long:    toolbox_bastion_long,
+		Example: toolbox_bastion_example,
+	}
+
+	startOptions := &ToolboxBastionStartOptions{}
+	startOptions.InitDefaults()
+
+	startCmd := &cobra.Command{
+		Use:   "start",
+		Short: i18n.T(`Launch a temporary bastion instance.`),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = RunToolboxBastionStart(f, os.Stdout, startOptions)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	startCmd.Flags().StringVar(&startOptions.InstanceType, "instance-type", startOptions.InstanceType, "EC2 instance type for the bastion")
+	startCmd.Flags().StringVar(&startOptions.Image, "image", startOptions.Image, "AMI to use (default: the image of the first instance group)")
+	startCmd.Flags().StringVar(&startOptions.SSHAccess, "ssh-access", startOptions.SSHAccess, "CIDR allowed to SSH to the bastion")
+	cmd.AddCommand(startCmd)
+
+	stopCmd := &cobra.Command{
+		Use:   "stop",
+		Short: i18n.T(`Terminate the temporary bastion instance.`),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = RunToolboxBastionStop(f, os.Stdout)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	cmd.AddCommand(stopCmd)
+
+	return cmd
+}
+
+func RunToolboxBastionStart(f *util.Factory, out io.Writer, options *ToolboxBastionStartOptions) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+	clusterName := cluster.ObjectMeta.Name
+
+	if cluster.Spec.Topology == nil || cluster.Spec.Topology.Nodes != api.TopologyPrivate {
+		glog.Warningf("cluster %q does not use private topology; its instances may be reachable directly", clusterName)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return fmt.Errorf("on-demand bastions are only implemented for AWS")
+	}
+
+	if err := rootCommand.AssumeRoleForMutation(); err != nil {
+		return err
+	}
+
+	name := onDemandBastionName(clusterName)
+
+	existing, err := findOnDemandBastion(awsCloud, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		fmt.Fprintf(out, "Bastion %s is already running\n", aws.StringValue(existing.InstanceId))
+		printBastionAddress(out, existing)
+		return nil
+	}
+
+	subnet, err := findUtilitySubnet(awsCloud)
+	if err != nil {
+		return err
+	}
+
+	securityGroupID, err := findOrCreateBastionSecurityGroup(awsCloud, cluster, aws.StringValue(subnet.VpcId), options.SSHAccess)
+	if err != nil {
+		return err
+	}
+
+	imageID, err := resolveBastionImage(f, cluster, awsCloud, options.Image)
+	if err != nil {
+		return err
+	}
+
+	keyName, err := findClusterKeyPair(awsCloud, clusterName)
+	if err != nil {
+		return err
+	}
+
+	request := &ec2.RunInstancesInput{
+		ImageId:      aws.String(imageID),
+		InstanceType: aws.String(options.InstanceType),
+		MinCount:     aws.Int64(1),
+		MaxCount:     aws.Int64(1),
+		NetworkInterfaces: []*ec2.InstanceNetworkInterfaceSpecification{
+			{
+				DeviceIndex:              aws.Int64(0),
+				AssociatePublicIpAddress: aws.Bool(true),
+				SubnetId:                 subnet.SubnetId,
+				Groups:                   []*string{aws.String(securityGroupID)},
+			},
+		},
+	}
+	if keyName != "" {
+		request.KeyName = aws.String(keyName)
+	}
+
+	glog.V(2).Infof("Launching on-demand bastion in subnet %q", aws.StringValue(subnet.SubnetId))
+	reservation, err := awsCloud.EC2().RunInstances(request)
+	if err != nil {
+		return fmt.Errorf("error launching bastion instance: %v", err)
+	}
+	instanceID := aws.StringValue(reservation.Instances[0].InstanceId)
+
+	err = awsCloud.CreateTags(instanceID, awsCloud.BuildTags(aws.String(name)))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Launched bastion %s; waiting for a public address\n", instanceID)
+
+	instance, err := waitForBastionAddress(awsCloud, instanceID)
+	if err != nil {
+		return err
+	}
+	printBastionAddress(out, instance)
+	fmt.Fprintf(out, "\nRun kops toolbox bastion stop when you are done\n")
+	return nil
+}
+
+func RunToolboxBastionStop(f *util.Factory, out io.Writer) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+	clusterName := cluster.ObjectMeta.Name
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return fmt.Errorf("on-demand bastions are only implemented for AWS")
+	}
+
+	if err := rootCommand.AssumeRoleForMutation(); err != nil {
+		return err
+	}
+
+	name := onDemandBastionName(clusterName)
+
+	instance, err := findOnDemandBastion(awsCloud, name)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		fmt.Fprintf(out, "No on-demand bastion found for cluster %q\n", clusterName)
+	} else {
+		instanceID := aws.StringValue(instance.InstanceId)
+		fmt.Fprintf(out, "Terminating bastion %s\n", instanceID)
+		_, err = awsCloud.EC2().TerminateInstances(&ec2.TerminateInstancesInput{
+			InstanceIds: []*string{&instanceID},
+		})
+		if err != nil {
+			return fmt.Errorf("error terminating bastion %q: %v", instanceID, err)
+		}
+	}
+
+	err = deleteBastionSecurityGroup(awsCloud, name)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// findOnDemandBastion looks up the temporary bastion instance by its Name tag
+func findOnDemandBastion(awsCloud awsup.AWSCloud, name string) (*ec2.Instance, error) {
+	request := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			awsup.NewEC2Filter("tag:Name", name),
+			awsup.NewEC2Filter("instance-state-name", "pending", "running"),
+		},
+	}
+	response, err := awsCloud.EC2().DescribeInstances(request)
+	if err != nil {
+		return nil, fmt.Errorf("error listing bastion instances: %v", err)
+	}
+	for _, reservation := range response.Reservations {
+		for _, instance := range reservation.Instances {
+			return instance, nil
+		}
+	}
+	return nil, nil
+}
+
+// findUtilitySubnet picks a cluster subnet suitable for a public-facing
+// bastion: a utility subnet in a private topology, otherwise any cluster subnet
+func findUtilitySubnet(awsCloud awsup.AWSCloud) (*ec2.Subnet, error) {
+	request := &ec2.DescribeSubnetsInput{
+		Filters: resources.BuildEC2Filters(awsCloud),
+	}
+	response, err := awsCloud.EC2().DescribeSubnets(request)
+	if err != nil {
+		return nil, fmt.Errorf("error listing subnets: %v", err)
+	}
+
+	var fallback *ec2.Subnet
+	for _, subnet := range response.Subnets {
+		name := resources.FindName(subnet.Tags)
+		if strings.HasPrefix(name, "utility-") {
+			return subnet, nil
+		}
+		if fallback == nil {
+			fallback = subnet
+		}
+	}
+	if fallback == nil {
+		return nil, fmt.Errorf("cannot find a cluster subnet for the bastion")
+	}
+	return fallback, nil
+}
+
+// findOrCreateBastionSecurityGroup reuses the security group of a permanent
+// bastion instance group if the cluster has one; otherwise it creates a
+// temporary group allowing SSH from sshAccess, which stop will delete
+func findOrCreateBastionSecurityGroup(awsCloud awsup.AWSCloud, cluster *api.Cluster, vpcID string, sshAccess string) (string, error) {
+	clusterName := cluster.ObjectMeta.Name
+	name := onDemandBastionName(clusterName)
+
+	for _, groupName := range []string{name, "bastion." + clusterName} {
+		request := &ec2.DescribeSecurityGroupsInput{
+			Filters: []*ec2.Filter{
+				awsup.NewEC2Filter("group-name", groupName),
+				awsup.NewEC2Filter("vpc-id", vpcID),
+			},
+		}
+		response, err := awsCloud.EC2().DescribeSecurityGroups(request)
+		if err != nil {
+			return "", fmt.Errorf("error listing security groups: %v", err)
+		}
+		for _, group := range response.SecurityGroups {
+			return aws.StringValue(group.GroupId), nil
+		}
+	}
+
+	glog.V(2).Infof("Creating temporary bastion security group %q", name)
+	created, err := awsCloud.EC2().CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
+		GroupName:   aws.String(name),
+		Description: aws.String("Temporary on-demand bastion for " + clusterName),
+		VpcId:       aws.String(vpcID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating bastion security group: %v", err)
+	}
+	groupID := aws.StringValue(created.GroupId)
+
+	err = awsCloud.CreateTags(groupID, awsCloud.BuildTags(aws.String(name)))
+	if err != nil {
+		return "", err
+	}
+
+	_, err = awsCloud.EC2().AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:    aws.String(groupID),
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(22),
+		ToPort:     aws.Int64(22),
+		CidrIp:     aws.String(sshAccess),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error authorizing SSH to bastion security group: %v", err)
+	}
+
+	return groupID, nil
+}
+
+// deleteBastionSecurityGroup removes the temporary security group, if start
+// created one; it retries while the terminating instance still references it
+func deleteBastionSecurityGroup(awsCloud awsup.AWSCloud, name string) error {
+	request := &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			awsup.NewEC2Filter("group-name", name),
+		},
+	}
+	response, err := awsCloud.EC2().DescribeSecurityGroups(request)
+	if err != nil {
+		return fmt.Errorf("error listing security groups: %v", err)
+	}
+	if len(response.SecurityGroups) == 0 {
+		return nil
+	}
+	groupID := aws.StringValue(response.SecurityGroups[0].GroupId)
+
+	glog.V(2).Infof("Deleting temporary bastion security group %q", groupID)
+	for attempt := 0; attempt < 30; attempt++ {
+		_, err = awsCloud.EC2().DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+			GroupId: aws.String(groupID),
+		})
+		if err == nil {
+			return nil
+		}
+		if !resources.IsDependencyViolation(err) {
+			return fmt.Errorf("error deleting bastion security group %q: %v", groupID, err)
+		}
+		time.Sleep(10 * time.Second)
+	}
+	return fmt.Errorf("timed out waiting to delete bastion security group %q", groupID)
+}
+
+// resolveBastionImage picks the AMI for the bastion: the --image flag if set,
+// otherwise the image of the first instance group
+func resolveBastionImage(f *util.Factory, cluster *api.Cluster, awsCloud awsup.AWSCloud, imageOverride string) (string, error) {
+	imageName := imageOverride
+	if imageName == "" {
+		clientSet, err := f.Clientset()
+		if err != nil {
+			return "", err
+		}
+		list, err := clientSet.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+		if err != nil {
+			return "", err
+		}
+		for i := range list.Items {
+			if list.Items[i].Spec.Image != "" {
+				imageName = list.Items[i].Spec.Image
+				break
+			}
+		}
+	}
+	if imageName == "" {
+		return "", fmt.Errorf("cannot determine an image for the bastion; pass --image")
+	}
+
+	image, err := awsCloud.ResolveImage(imageName)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(image.ImageId), nil
+}
+
+// findClusterKeyPair looks up the SSH key pair kops registered for the
+// cluster; an empty result launches the bastion without a key
+func findClusterKeyPair(awsCloud awsup.AWSCloud, clusterName string) (string, error) {
+	request := &ec2.DescribeKeyPairsInput{
+		Filters: []*ec2.Filter{
+			awsup.NewEC2Filter("key-name", "kubernetes."+clusterName+"-*"),
+		},
+	}
+	response, err := awsCloud.EC2().DescribeKeyPairs(request)
+	if err != nil {
+		return "", fmt.Errorf("error listing key pairs: %v", err)
+	}
+	for _, keyPair := range response.KeyPairs {
+		return aws.StringValue(keyPair.KeyName), nil
+	}
+
+	glog.Warningf("cannot find an SSH key pair for cluster %q; launching bastion without one", clusterName)
+	return "", nil
+}
+
+// waitForBastionAddress polls until the bastion has a public IP address
+func waitForBastionAddress(awsCloud awsup.AWSCloud, instanceID string) (*ec2.Instance, error) {
+	for attempt := 0; attempt < 30; attempt++ {
+		response, err := awsCloud.EC2().DescribeInstances(&ec2.DescribeInstancesInput{
+			InstanceIds: []*string{&instanceID},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error describing bastion %q: %v", instanceID, err)
+		}
+		for _, reservation := range response.Reservations {
+			for _, instance := range reservation.Instances {
+				if aws.StringValue(instance.PublicIpAddress) != "" {
+					return instance, nil
+				}
+			}
+		}
+		time.Sleep(10 * time.Second)
+	}
+	return nil, fmt.Errorf("timed out waiting for bastion %q to get a public address", instanceID)
+}
+
+func printBastionAddress(out io.Writer, instance *ec2.Instance) {
+	address := aws.StringValue(instance.PublicIpAddress)
+	fmt.Fprintf(out, "Bastion public address: %s\n", address)
+	fmt.Fprintf(out, "SSH to the cluster with: ssh -A admin@%s\n", address)
+}
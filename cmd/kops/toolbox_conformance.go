@@ -0,0 +1,232 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/validation"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_conformance_long = templates.LongDesc(i18n.T(`
+	Run the standard cluster validation plus a set of configuration compliance
+	checks (authorization mode, anonymous auth, image tags, version skew) against
+	a running cluster, reporting health and compliance in one place.`))
+
+	toolbox_conformance_example = templates.Examples(i18n.T(`
+	# Validate health and compliance of a cluster
+	kops toolbox conformance --name k8s-cluster.example.com
+	`))
+
+	toolbox_conformance_short = i18n.T(`Check cluster health and configuration compliance`)
+)
+
+// conformanceCheck is the outcome of one compliance check
+type conformanceCheck struct {
+	Name    string
+	Status  string
+	Message string
+}
+
+const (
+	conformancePass = "PASS"
+	conformanceWarn = "WARN"
+	conformanceFail = "FAIL"
+)
+
+func NewCmdToolboxConformance(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "conformance",
+		Short:   toolbox_conformance_short,
+		Long:    toolbox_conformance_long,
+		Example: toolbox_conformance_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = RunToolboxConformance(f, out)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func RunToolboxConformance(f *util.Factory, out io.Writer) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	list, err := clientSet.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	contextName := cluster.ObjectMeta.Name
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{CurrentContext: contextName}).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("Cannot load kubecfg settings for %q: %v\n", contextName, err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("Cannot build kube api client for %q: %v\n", contextName, err)
+	}
+
+	fmt.Fprintf(out, "Validating cluster %v\n\n", cluster.ObjectMeta.Name)
+
+	validationCluster, validationFailed := validation.ValidateCluster(cluster.ObjectMeta.Name, list, k8sClient)
+	if validationCluster == nil || validationCluster.NodeList == nil {
+		return withExitCode(ExitCodeValidationFailed, validationFailed)
+	}
+
+	if validationFailed == nil {
+		fmt.Fprintf(out, "Cluster is healthy: %d master(s), %d node(s) ready\n", len(validationCluster.MastersReadyArray), len(validationCluster.NodesReadyArray))
+	} else {
+		fmt.Fprintf(out, "Cluster validation failed: %v\n", validationFailed)
+	}
+
+	checks := runConformanceChecks(cluster, validationCluster, k8sClient)
+
+	t := &tables.Table{}
+	t.AddColumn("CHECK", func(c conformanceCheck) string {
+		return c.Name
+	})
+	t.AddColumn("STATUS", func(c conformanceCheck) string {
+		return c.Status
+	})
+	t.AddColumn("MESSAGE", func(c conformanceCheck) string {
+		return c.Message
+	})
+
+	fmt.Fprintln(out, "\nCONFORMANCE")
+	if err := t.Render(checks, out, "CHECK", "STATUS", "MESSAGE"); err != nil {
+		return fmt.Errorf("cannot render conformance checks: %v", err)
+	}
+
+	failed := validationFailed != nil
+	for _, c := range checks {
+		if c.Status == conformanceFail {
+			failed = true
+		}
+	}
+	if failed {
+		return withExitCode(ExitCodeValidationFailed, fmt.Errorf("cluster %q failed conformance checks", cluster.ObjectMeta.Name))
+	}
+
+	fmt.Fprintf(out, "\nYour cluster %s passes all conformance checks\n", cluster.ObjectMeta.Name)
+	return nil
+}
+
+func runConformanceChecks(cluster *api.Cluster, v *validation.ValidationCluster, k8sClient kubernetes.Interface) []conformanceCheck {
+	var checks []conformanceCheck
+
+	// Authorization mode should not be AlwaysAllow
+	{
+		c := conformanceCheck{Name: "authorization-mode"}
+		authorization := cluster.Spec.Authorization
+		if authorization == nil || authorization.IsEmpty() || authorization.AlwaysAllow != nil {
+			c.Status = conformanceWarn
+			c.Message = "authorization is AlwaysAllow; consider RBAC"
+		} else {
+			c.Status = conformancePass
+		}
+		checks = append(checks, c)
+	}
+
+	// Anonymous auth to the kubelet API should be disabled
+	{
+		c := conformanceCheck{Name: "kubelet-anonymous-auth"}
+		kubelet := cluster.Spec.Kubelet
+		if kubelet != nil && kubelet.AnonymousAuth != nil && !fi.BoolValue(kubelet.AnonymousAuth) {
+			c.Status = conformancePass
+		} else {
+			c.Status = conformanceWarn
+			c.Message = "anonymous auth to the kubelet API is not disabled"
+		}
+		checks = append(checks, c)
+	}
+
+	// kube-system images should be pinned, not :latest
+	{
+		c := conformanceCheck{Name: "pinned-system-images", Status: conformancePass}
+		pods, err := k8sClient.CoreV1().Pods("kube-system").List(metav1.ListOptions{})
+		if err != nil {
+			c.Status = conformanceWarn
+			c.Message = fmt.Sprintf("cannot list kube-system pods: %v", err)
+		} else {
+			var unpinned []string
+			for _, pod := range pods.Items {
+				for _, container := range pod.Spec.Containers {
+					if strings.HasSuffix(container.Image, ":latest") || !strings.Contains(container.Image, ":") {
+						unpinned = append(unpinned, pod.Name)
+					}
+				}
+			}
+			if len(unpinned) != 0 {
+				c.Status = conformanceFail
+				c.Message = fmt.Sprintf("pods with unpinned images: %s", strings.Join(unpinned, ","))
+			}
+		}
+		checks = append(checks, c)
+	}
+
+	// Kubelet versions should match the cluster version
+	{
+		c := conformanceCheck{Name: "kubelet-version-skew", Status: conformancePass}
+		var skewed []string
+		for i := range v.NodeList.Items {
+			node := &v.NodeList.Items[i]
+			kubeletVersion := node.Status.NodeInfo.KubeletVersion
+			if kubeletVersion != "" && !strings.HasPrefix(strings.TrimPrefix(kubeletVersion, "v"), cluster.Spec.KubernetesVersion) {
+				skewed = append(skewed, fmt.Sprintf("%s (%s)", node.Name, kubeletVersion))
+			}
+		}
+		if len(skewed) != 0 {
+			c.Status = conformanceWarn
+			c.Message = fmt.Sprintf("nodes not on %s: %s", cluster.Spec.KubernetesVersion, strings.Join(skewed, ","))
+		}
+		checks = append(checks, c)
+	}
+
+	return checks
+}
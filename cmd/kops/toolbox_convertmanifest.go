@@ -0,0 +1,116 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_convertmanifest_long = templates.LongDesc(i18n.T(`
+	Read a manifest containing kops objects (Cluster, InstanceGroup) and rewrite
+	it in another API version, for upgrading stored specs to a newer version.`))
+
+	toolbox_convertmanifest_example = templates.Examples(i18n.T(`
+	# Rewrite a cluster spec in the v1alpha3 API version
+	kops toolbox convert-manifest --filename cluster.yaml --api-version v1alpha3
+	`))
+
+	toolbox_convertmanifest_short = i18n.T(`Convert a manifest between API versions`)
+)
+
+type ToolboxConvertManifestOptions struct {
+	// Filename is the manifest to convert
+	Filename string
+
+	// APIVersion is the target API version
+	APIVersion string
+}
+
+func (o *ToolboxConvertManifestOptions) InitDefaults() {
+	o.APIVersion = "v1alpha3"
+}
+
+func NewCmdToolboxConvertManifest(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxConvertManifestOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:     "convert-manifest",
+		Short:   toolbox_convertmanifest_short,
+		Long:    toolbox_convertmanifest_long,
+		Example: toolbox_convertmanifest_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunToolboxConvertManifest(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Filename, "filename", "f", options.Filename, "Manifest file to convert")
+	cmd.Flags().StringVar(&options.APIVersion, "api-version", options.APIVersion, "Target API version")
+
+	return cmd
+}
+
+func RunToolboxConvertManifest(f *util.Factory, out io.Writer, options *ToolboxConvertManifestOptions) error {
+	if options.Filename == "" {
+		return fmt.Errorf("--filename is required")
+	}
+
+	data, err := ioutil.ReadFile(options.Filename)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %v", options.Filename, err)
+	}
+
+	// A manifest can hold multiple objects, separated by "---"
+	sections := bytes.Split(data, []byte("\n---\n"))
+	for i, section := range sections {
+		if len(bytes.TrimSpace(section)) == 0 {
+			continue
+		}
+
+		o, gvk, err := api.ParseVersionedYaml(section)
+		if err != nil {
+			return fmt.Errorf("error parsing object in %q: %v", options.Filename, err)
+		}
+
+		converted, err := api.ToVersionedYamlWithVersion(o, options.APIVersion)
+		if err != nil {
+			return fmt.Errorf("error converting %s to %s: %v", gvk.Kind, options.APIVersion, err)
+		}
+
+		if i != 0 {
+			fmt.Fprintf(out, "---\n\n")
+		}
+		if _, err := out.Write(converted); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_costreport_long = templates.LongDesc(i18n.T(`
+	Reports actual cloud costs for the cluster, broken down by instance group,
+	for chargeback.
+
+	For AWS the costs are queried from Cost Explorer, attributed to instance
+	groups through the autoscaling group tag; the kops cluster tag
+	(KubernetesCluster) and the aws:autoscaling:groupName tag must be activated
+	as cost allocation tags in the billing console, and the caller needs the
+	ce:GetCostAndUsage permission.  Costs that carry the cluster tag but no
+	autoscaling group tag (e.g. EBS volumes and ELBs) are reported as untagged.
+
+	GCE billing data is only available through the BigQuery billing export, so
+	for GCE this command generates the SQL statement to run against your export
+	table instead of querying costs itself.`))
+
+	toolbox_costreport_example = templates.Examples(i18n.T(`
+	# Report last month's costs for an AWS cluster
+	kops toolbox cost-report --name k8s-cluster.example.com -o csv
+
+	# Report costs for a specific period
+	kops toolbox cost-report --name k8s-cluster.example.com --start 2017-01-01 --end 2017-02-01 -o json
+
+	# Generate a BigQuery statement for a GCE cluster billing export
+	kops toolbox cost-report --name k8s-cluster.example.com -o sql
+	`))
+
+	toolbox_costreport_short = i18n.T(`Report cloud costs per instance group`)
+)
+
+type ToolboxCostReportOptions struct {
+	Output string
+
+	// Start and End bound the reporting period (YYYY-MM-DD, End exclusive);
+	// the default is the last 30 days
+	Start string
+	End   string
+
+	ClusterName string
+}
+
+func (o *ToolboxCostReportOptions) InitDefaults() {
+	o.Output = OutputJSON
+
+	now := time.Now().UTC()
+	o.Start = now.AddDate(0, 0, -30).Format("2006-01-02")
+	o.End = now.Format("2006-01-02")
+}
+
+// costReportLine is one row of the cost report
+type costReportLine struct {
+	// Cluster is the cluster name
+	Cluster string `json:"cluster"`
+	// InstanceGroup is the instance group the costs are attributed to; empty for the cluster total
+	InstanceGroup string `json:"instanceGroup,omitempty"`
+	// Start and End bound the reporting period (End exclusive)
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Cost is the unblended cost for the period
+	Cost float64 `json:"cost"`
+	// Currency is the billing currency, e.g. USD
+	Currency string `json:"currency,omitempty"`
+}
+
+func NewCmdToolboxCostReport(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxCostReportOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:     "cost-report",
+		Short:   toolbox_costreport_short,
+		Long:    toolbox_costreport_long,
+		Example: toolbox_costreport_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := rootCommand.ProcessArgs(args); err != nil {
+				exitWithError(err)
+			}
+
+			options.ClusterName = rootCommand.ClusterName()
+
+			err := RunToolboxCostReport(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "output format.  One of: json, csv, sql")
+	cmd.Flags().StringVar(&options.Start, "start", options.Start, "first day of the reporting period (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&options.End, "end", options.End, "day after the last day of the reporting period (YYYY-MM-DD)")
+
+	return cmd
+}
+
+func RunToolboxCostReport(f *util.Factory, out io.Writer, options *ToolboxCostReportOptions) error {
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	cluster, err := clientset.GetCluster(options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	list, err := clientset.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	switch api.CloudProviderID(cluster.Spec.CloudProvider) {
+	case api.CloudProviderAWS:
+		lines, err := awsCostReport(cluster, list, options)
+		if err != nil {
+			return err
+		}
+		return writeCostReport(out, options.Output, lines)
+
+	case api.CloudProviderGCE:
+		// There is no billing query API for GCE; we can only generate the
+		// statement to run against the BigQuery billing export
+		sql := gceBillingSQL(cluster.ObjectMeta.Name)
+		switch options.Output {
+		case "sql":
+			fmt.Fprintf(out, "%s\n", sql)
+			return nil
+		case OutputJSON:
+			b, err := json.MarshalIndent(map[string]string{"cluster": cluster.ObjectMeta.Name, "bigQuerySQL": sql}, "", "  ")
+			if err != nil {
+				return fmt.Errorf("error marshaling json: %v", err)
+			}
+			fmt.Fprintf(out, "%s\n", b)
+			return nil
+		default:
+			return fmt.Errorf("GCE costs must be queried through the BigQuery billing export; use -o sql to generate the statement")
+		}
+
+	default:
+		return fmt.Errorf("cost-report is not supported for cloud provider %q", cluster.Spec.CloudProvider)
+	}
+}
+
+// awsCostReport queries Cost Explorer for the cluster's costs, grouped by the
+// autoscaling group cost allocation tag, and maps the groups back to instance groups
+func awsCostReport(cluster *api.Cluster, list *api.InstanceGroupList, options *ToolboxCostReportOptions) ([]*costReportLine, error) {
+	ce, err := awsup.NewCostExplorer()
+	if err != nil {
+		return nil, err
+	}
+
+	igByASGName := make(map[string]string)
+	for i := range list.Items {
+		ig := &list.Items[i]
+		igByASGName[igASGName(cluster, ig)] = ig.ObjectMeta.Name
+	}
+
+	input := &awsup.GetCostAndUsageInput{
+		TimePeriod: &awsup.DateInterval{
+			Start: aws.String(options.Start),
+			End:   aws.String(options.End),
+		},
+		Granularity: aws.String("MONTHLY"),
+		Metrics:     []*string{aws.String("UnblendedCost")},
+		Filter: &awsup.Expression{
+			Tags: &awsup.TagValues{
+				Key:    aws.String(awsup.TagClusterName),
+				Values: []*string{aws.String(cluster.ObjectMeta.Name)},
+			},
+		},
+		GroupBy: []*awsup.GroupDefinition{
+			{Type: aws.String("TAG"), Key: aws.String("aws:autoscaling:groupName")},
+		},
+	}
+
+	costs := make(map[string]float64)
+	currency := ""
+	for {
+		output, err := ce.GetCostAndUsage(input)
+		if err != nil {
+			return nil, fmt.Errorf("error querying Cost Explorer: %v", err)
+		}
+
+		for _, result := range output.ResultsByTime {
+			for _, group := range result.Groups {
+				if len(group.Keys) == 0 {
+					continue
+				}
+				// Group keys have the form <tagKey>$<tagValue>
+				key := aws.StringValue(group.Keys[0])
+				if i := strings.Index(key, "$"); i >= 0 {
+					key = key[i+1:]
+				}
+
+				metric := group.Metrics["UnblendedCost"]
+				if metric == nil {
+					continue
+				}
+				amount, err := strconv.ParseFloat(aws.StringValue(metric.Amount), 64)
+				if err != nil {
+					return nil, fmt.Errorf("cannot parse cost amount %q: %v", aws.StringValue(metric.Amount), err)
+				}
+
+				name := igByASGName[key]
+				if name == "" && key != "" {
+					// An autoscaling group we don't recognize, e.g. an
+					// instance group deleted during the period
+					name = key
+				}
+				costs[name] += amount
+				if metric.Unit != nil {
+					currency = aws.StringValue(metric.Unit)
+				}
+			}
+		}
+
+		if output.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = output.NextPageToken
+	}
+
+	var names []string
+	total := 0.0
+	for name, cost := range costs {
+		if name != "" {
+			names = append(names, name)
+		}
+		total += cost
+	}
+	sort.Strings(names)
+
+	var lines []*costReportLine
+	for _, name := range names {
+		lines = append(lines, &costReportLine{
+			Cluster:       cluster.ObjectMeta.Name,
+			InstanceGroup: name,
+			Start:         options.Start,
+			End:           options.End,
+			Cost:          costs[name],
+			Currency:      currency,
+		})
+	}
+	if cost, found := costs[""]; found {
+		lines = append(lines, &costReportLine{
+			Cluster:       cluster.ObjectMeta.Name,
+			InstanceGroup: "(untagged)",
+			Start:         options.Start,
+			End:           options.End,
+			Cost:          cost,
+			Currency:      currency,
+		})
+	}
+	lines = append(lines, &costReportLine{
+		Cluster:  cluster.ObjectMeta.Name,
+		Start:    options.Start,
+		End:      options.End,
+		Cost:     total,
+		Currency: currency,
+	})
+
+	return lines, nil
+}
+
+func writeCostReport(out io.Writer, format string, lines []*costReportLine) error {
+	switch format {
+	case OutputJSON:
+		b, err := json.MarshalIndent(lines, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling json: %v", err)
+		}
+		fmt.Fprintf(out, "%s\n", b)
+		return nil
+
+	case "csv":
+		w := csv.NewWriter(out)
+		if err := w.Write([]string{"cluster", "instanceGroup", "start", "end", "cost", "currency"}); err != nil {
+			return fmt.Errorf("error writing csv: %v", err)
+		}
+		for _, l := range lines {
+			record := []string{l.Cluster, l.InstanceGroup, l.Start, l.End, strconv.FormatFloat(l.Cost, 'f', -1, 64), l.Currency}
+			if err := w.Write(record); err != nil {
+				return fmt.Errorf("error writing csv: %v", err)
+			}
+		}
+		w.Flush()
+		return w.Error()
+
+	default:
+		return fmt.Errorf("Unsupported output format: %q", format)
+	}
+}
+
+func igASGName(cluster *api.Cluster, ig *api.InstanceGroup) string {
+	if ig.Spec.Role == api.InstanceGroupRoleMaster {
+		return ig.ObjectMeta.Name + ".masters." + cluster.ObjectMeta.Name
+	}
+	return ig.ObjectMeta.Name + "." + cluster.ObjectMeta.Name
+}
+
+func gceBillingSQL(clusterName string) string {
+	// The billing export table name must be substituted by the caller
+	return fmt.Sprintf(
+		"SELECT label.value AS cluster, SUM(cost) AS total_cost"+
+			" FROM `BILLING_EXPORT_TABLE`, UNNEST(labels) AS label"+
+			" WHERE label.key = 'k8s-io-cluster-name' AND label.value = '%s'"+
+			" GROUP BY cluster",
+		clusterName)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubernetes/federation/pkg/dnsprovider"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_dnssetup_long = templates.LongDesc(i18n.T(`
+	Verifies the DNS setup required for the cluster: that a hosted zone for the
+	cluster domain exists, and that the delegation chain from the public DNS
+	hierarchy actually reaches that zone's nameservers.
+
+	A misconfigured zone delegation is one of the most common reasons a new
+	cluster never validates; this command diagnoses it before the cluster is
+	created.  With --yes a missing hosted zone is created.`))
+
+	toolbox_dnssetup_example = templates.Examples(i18n.T(`
+	# Verify the hosted zone and delegation for a cluster
+	kops toolbox dns-setup --name k8s-cluster.example.com
+
+	# Create the hosted zone if it is missing
+	kops toolbox dns-setup --name k8s-cluster.example.com --yes
+	`))
+
+	toolbox_dnssetup_short = i18n.T(`Verify (or create) the DNS zone and delegation for a cluster`)
+)
+
+type ToolboxDNSSetupOptions struct {
+	Yes bool
+
+	ClusterName string
+}
+
+func NewCmdToolboxDNSSetup(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxDNSSetupOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "dns-setup",
+		Short:   toolbox_dnssetup_short,
+		Long:    toolbox_dnssetup_long,
+		Example: toolbox_dnssetup_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := rootCommand.ProcessArgs(args); err != nil {
+				exitWithError(err)
+			}
+
+			options.ClusterName = rootCommand.ClusterName()
+
+			err := RunToolboxDNSSetup(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Yes, "yes", options.Yes, "Create the hosted zone if it does not exist")
+
+	return cmd
+}
+
+func RunToolboxDNSSetup(f *util.Factory, out io.Writer, options *ToolboxDNSSetupOptions) error {
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	cluster, err := clientset.GetCluster(options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	dnsProvider, err := cloud.DNS()
+	if err != nil {
+		return fmt.Errorf("error building DNS provider: %v", err)
+	}
+
+	zonesProvider, ok := dnsProvider.Zones()
+	if !ok {
+		return fmt.Errorf("error getting DNS zones provider")
+	}
+
+	zones, err := zonesProvider.List()
+	if err != nil {
+		return fmt.Errorf("error listing DNS zones: %v", err)
+	}
+
+	zoneName := strings.TrimSuffix(cluster.Spec.DNSZone, ".")
+	if zoneName == "" {
+		// Guess the zone by stripping the first label of the cluster name,
+		// matching the behaviour of create cluster
+		tokens := strings.Split(options.ClusterName, ".")
+		if len(tokens) < 3 {
+			return fmt.Errorf("cannot infer DNS zone for cluster %q; set spec.dnsZone", options.ClusterName)
+		}
+		zoneName = strings.Join(tokens[1:], ".")
+	}
+
+	var zone dnsprovider.Zone
+	for _, z := range zones {
+		if strings.TrimSuffix(z.Name(), ".") == zoneName {
+			zone = z
+			break
+		}
+	}
+
+	if zone == nil {
+		fmt.Fprintf(out, "Hosted zone %q not found\n", zoneName)
+		if !options.Yes {
+			fmt.Fprintf(out, "Run with --yes to create it\n")
+			return fmt.Errorf("hosted zone %q does not exist", zoneName)
+		}
+		newZone, err := zonesProvider.New(zoneName)
+		if err != nil {
+			return fmt.Errorf("error building hosted zone %q: %v", zoneName, err)
+		}
+		zone, err = zonesProvider.Add(newZone)
+		if err != nil {
+			return fmt.Errorf("error creating hosted zone %q: %v", zoneName, err)
+		}
+		fmt.Fprintf(out, "Created hosted zone %q (id %s)\n", zone.Name(), zone.ID())
+		fmt.Fprintf(out, "You must now create the NS records in the parent zone before the delegation works\n")
+	} else {
+		fmt.Fprintf(out, "Found hosted zone %q (id %s)\n", zone.Name(), zone.ID())
+	}
+
+	// The nameservers the zone itself claims
+	zoneNS, err := findZoneNameservers(zone)
+	if err != nil {
+		return err
+	}
+
+	if len(zoneNS) == 0 {
+		fmt.Fprintf(out, "Could not determine the zone's nameservers; skipping delegation check\n")
+		return nil
+	}
+
+	// The nameservers the public DNS hierarchy delegates to
+	delegatedNS, err := net.LookupNS(zoneName)
+	if err != nil {
+		fmt.Fprintf(out, "Delegation check FAILED: cannot resolve NS records for %q: %v\n", zoneName, err)
+		fmt.Fprintf(out, "Create NS records for %q in the parent zone pointing to:\n", zoneName)
+		for _, ns := range zoneNS {
+			fmt.Fprintf(out, "  %s\n", ns)
+		}
+		return fmt.Errorf("DNS delegation for %q is broken", zoneName)
+	}
+
+	var resolved []string
+	for _, ns := range delegatedNS {
+		resolved = append(resolved, strings.TrimSuffix(ns.Host, "."))
+	}
+	sort.Strings(resolved)
+	sort.Strings(zoneNS)
+
+	matched := false
+	for _, ns := range resolved {
+		for _, expected := range zoneNS {
+			if ns == expected {
+				matched = true
+			}
+		}
+	}
+
+	if !matched {
+		fmt.Fprintf(out, "Delegation check FAILED: the NS records for %q do not point to the hosted zone\n", zoneName)
+		fmt.Fprintf(out, "  delegated to: %s\n", strings.Join(resolved, ", "))
+		fmt.Fprintf(out, "  zone expects: %s\n", strings.Join(zoneNS, ", "))
+		return fmt.Errorf("DNS delegation for %q points to the wrong nameservers", zoneName)
+	}
+
+	fmt.Fprintf(out, "DNS delegation for %q is healthy\n", zoneName)
+	return nil
+}
+
+// findZoneNameservers reads the NS recordset at the zone apex
+func findZoneNameservers(zone dnsprovider.Zone) ([]string, error) {
+	rrsets, ok := zone.ResourceRecordSets()
+	if !ok {
+		return nil, fmt.Errorf("zone %q does not support resource records", zone.Name())
+	}
+
+	records, err := rrsets.Get(zone.Name())
+	if err != nil {
+		return nil, fmt.Errorf("error reading records for %q: %v", zone.Name(), err)
+	}
+
+	var nameservers []string
+	for _, rrset := range records {
+		if string(rrset.Type()) != "NS" {
+			continue
+		}
+		for _, rrdata := range rrset.Rrdatas() {
+			nameservers = append(nameservers, strings.TrimSuffix(rrdata, "."))
+		}
+	}
+	return nameservers, nil
+}
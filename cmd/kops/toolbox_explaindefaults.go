@@ -0,0 +1,163 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/loader"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_explaindefaults_long = templates.LongDesc(i18n.T(`
+	Build the completed cluster spec and report, for each field, which options
+	template or builder set its value, for debugging surprising rendered
+	configurations.  Fields carried unchanged from the user's cluster spec are
+	attributed to the user configuration.
+
+	With FIELD arguments only matching fields (by prefix) are shown.`))
+
+	toolbox_explaindefaults_example = templates.Examples(i18n.T(`
+	# Explain every completed-spec field
+	kops toolbox explain-defaults --name k8s-cluster.example.com
+
+	# Explain where the kubelet configuration comes from
+	kops toolbox explain-defaults kubelet --name k8s-cluster.example.com
+	`))
+
+	toolbox_explaindefaults_short = i18n.T(`Explain where completed cluster spec values come from.`)
+)
+
+type ToolboxExplainDefaultsOptions struct {
+	// Fields restricts output to fields matching these dotted paths (by prefix)
+	Fields []string
+}
+
+// explainedField is one row of explain-defaults output
+type explainedField struct {
+	Field string
+	Value string
+	SetBy string
+}
+
+func NewCmdToolboxExplainDefaults(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxExplainDefaultsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "explain-defaults [FIELD]...",
+		Short:   toolbox_explaindefaults_short,
+		Long:    toolbox_explaindefaults_long,
+		Example: toolbox_explaindefaults_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Fields = args
+
+			err := RunToolboxExplainDefaults(f, os.Stdout, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func RunToolboxExplainDefaults(f *util.Factory, out io.Writer, options *ToolboxExplainDefaultsOptions) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+
+	fullCluster, attribution, err := cloudup.PopulateClusterSpecWithAttribution(cluster)
+	if err != nil {
+		return err
+	}
+
+	fields, err := loader.FlattenFields(&fullCluster.Spec)
+	if err != nil {
+		return err
+	}
+
+	var rows []*explainedField
+	for path, value := range fields {
+		if !explainFieldMatches(path, options.Fields) {
+			continue
+		}
+
+		setBy := attribution[path]
+		if setBy == "" {
+			setBy = "user configuration"
+		}
+
+		rows = append(rows, &explainedField{
+			Field: path,
+			Value: value,
+			SetBy: setBy,
+		})
+	}
+
+	if len(rows) == 0 {
+		return fmt.Errorf("no completed-spec fields match %v", options.Fields)
+	}
+
+	sort.Sort(byExplainedField(rows))
+
+	t := &tables.Table{}
+	t.AddColumn("FIELD", func(r *explainedField) string {
+		return r.Field
+	})
+	t.AddColumn("VALUE", func(r *explainedField) string {
+		return r.Value
+	})
+	t.AddColumn("SET BY", func(r *explainedField) string {
+		return r.SetBy
+	})
+
+	return t.Render(rows, out, "FIELD", "VALUE", "SET BY")
+}
+
+// explainFieldMatches is true when the path matches any of the requested
+// fields, by exact name or prefix; no requested fields matches everything.
+// A leading "spec." on the request is tolerated, since that is how the field
+// appears in the cluster yaml.
+func explainFieldMatches(path string, requested []string) bool {
+	if len(requested) == 0 {
+		return true
+	}
+	for _, r := range requested {
+		r = strings.TrimPrefix(r, "spec.")
+		if path == r || strings.HasPrefix(path, r+".") || strings.HasPrefix(path, r+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+type byExplainedField []*explainedField
+
+func (a byExplainedField) Len() int           { return len(a) }
+func (a byExplainedField) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byExplainedField) Less(i, j int) bool { return a[i].Field < a[j].Field }
@@ -0,0 +1,156 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_gc_long = templates.LongDesc(i18n.T(`
+	Find cloud resources that are tagged for the cluster but not accounted for by
+	any instance group - instances launched by hand, or autoscaling groups left
+	behind by deleted instance groups - and optionally delete them.
+
+	Without --yes the command only lists the orphaned resources.`))
+
+	toolbox_gc_example = templates.Examples(i18n.T(`
+	# List orphaned resources
+	kops toolbox gc --name k8s-cluster.example.com
+
+	# Delete them
+	kops toolbox gc --name k8s-cluster.example.com --yes
+	`))
+
+	toolbox_gc_short = i18n.T(`Garbage collect orphaned cluster resources.`)
+)
+
+type ToolboxGCOptions struct {
+	// Yes actually deletes the orphaned resources, instead of just listing them
+	Yes bool
+}
+
+func NewCmdToolboxGC(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxGCOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "gc",
+		Short:   toolbox_gc_short,
+		Long:    toolbox_gc_long,
+		Example: toolbox_gc_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = RunToolboxGC(f, os.Stdout, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Yes, "yes", options.Yes, "Delete the orphaned resources")
+
+	return cmd
+}
+
+func RunToolboxGC(f *util.Factory, out io.Writer, options *ToolboxGCOptions) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	list, err := clientSet.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	var instanceGroups []*api.InstanceGroup
+	for i := range list.Items {
+		instanceGroups = append(instanceGroups, &list.Items[i])
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	orphans, err := resources.FindOrphanResources(cloud, cluster, instanceGroups)
+	if err != nil {
+		return err
+	}
+
+	if len(orphans) == 0 {
+		fmt.Fprintf(out, "No orphaned resources found for cluster %q\n", cluster.ObjectMeta.Name)
+		return nil
+	}
+
+	t := &tables.Table{}
+	t.AddColumn("TYPE", func(o *resources.OrphanResource) string {
+		return o.Type
+	})
+	t.AddColumn("ID", func(o *resources.OrphanResource) string {
+		return o.ID
+	})
+	t.AddColumn("REASON", func(o *resources.OrphanResource) string {
+		return o.Reason
+	})
+
+	err = t.Render(orphans, out, "TYPE", "ID", "REASON")
+	if err != nil {
+		return err
+	}
+
+	if !options.Yes {
+		fmt.Fprintf(out, "\nMust specify --yes to delete the orphaned resources\n")
+		return nil
+	}
+
+	if err := rootCommand.AssumeRoleForMutation(); err != nil {
+		return err
+	}
+
+	for _, orphan := range orphans {
+		fmt.Fprintf(out, "Deleting %s %q\n", orphan.Type, orphan.ID)
+		err = resources.DeleteOrphanResource(cloud, orphan)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "\nDeleted %d orphaned resource(s)\n", len(orphans))
+	return nil
+}
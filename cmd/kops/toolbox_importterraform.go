@@ -0,0 +1,210 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_importterraform_long = templates.LongDesc(i18n.T(`
+	Emit the terraform import commands needed to adopt the live resources of a
+	cluster previously created directly by kops into the Terraform config that
+	kops update cluster --target=terraform generates.
+
+	Run kops update cluster --target=terraform first, then run the emitted
+	commands from the directory containing the generated config.  This migrates a
+	cluster to the terraform target without recreating anything.`))
+
+	toolbox_importterraform_example = templates.Examples(i18n.T(`
+	# Generate the terraform config, then the import commands
+	kops update cluster --name k8s-cluster.example.com --target=terraform --out=out/terraform
+	kops toolbox import-terraform --name k8s-cluster.example.com
+	`))
+
+	toolbox_importterraform_short = i18n.T(`Emit terraform import commands for an existing cluster.`)
+)
+
+func NewCmdToolboxImportTerraform(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "import-terraform",
+		Short:   toolbox_importterraform_short,
+		Long:    toolbox_importterraform_long,
+		Example: toolbox_importterraform_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = RunToolboxImportTerraform(f, os.Stdout)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func RunToolboxImportTerraform(f *util.Factory, out io.Writer) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+	clusterName := cluster.ObjectMeta.Name
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	d := &resources.ClusterResources{
+		ClusterName: clusterName,
+		Cloud:       cloud,
+	}
+	clusterResources, err := d.ListResources()
+	if err != nil {
+		return err
+	}
+
+	var commands []string
+	var skipped []string
+
+	for _, tracker := range clusterResources {
+		command, ok := terraformImportCommand(tracker)
+		if !ok {
+			skipped = append(skipped, fmt.Sprintf("%s %s", tracker.Type, tracker.ID))
+			continue
+		}
+		if command != "" {
+			commands = append(commands, command)
+		}
+	}
+
+	if len(commands) == 0 {
+		fmt.Fprintf(out, "# No importable resources found for cluster %q\n", clusterName)
+		return nil
+	}
+
+	sort.Strings(commands)
+	sort.Strings(skipped)
+
+	fmt.Fprintf(out, "# terraform import commands for cluster %q\n", clusterName)
+	fmt.Fprintf(out, "# Run from the directory containing the kops-generated terraform config\n")
+	for _, command := range commands {
+		fmt.Fprintf(out, "%s\n", command)
+	}
+
+	if len(skipped) != 0 {
+		fmt.Fprintf(out, "\n# Resources that must be imported by hand (or are not managed by the terraform config):\n")
+		for _, s := range skipped {
+			fmt.Fprintf(out, "#   %s\n", s)
+		}
+	}
+
+	return nil
+}
+
+// terraformImportCommand maps a live cluster resource to a terraform import
+// command for the matching resource in the kops-generated config.  An empty
+// command with ok=true means the resource is deliberately not imported
+// (e.g. instances, which the autoscaling groups manage).
+func terraformImportCommand(tracker *resources.ResourceTracker) (string, bool) {
+	// The terraform resource name is the task name with the same
+	// substitutions the terraform target applies
+	tfName := terraformName(tracker.Name)
+
+	switch tracker.Type {
+	case "vpc":
+		return fmt.Sprintf("terraform import aws_vpc.%s %s", tfName, tracker.ID), true
+	case "subnet":
+		return fmt.Sprintf("terraform import aws_subnet.%s %s", tfName, tracker.ID), true
+	case "security-group":
+		return fmt.Sprintf("terraform import aws_security_group.%s %s", tfName, tracker.ID), true
+	case "internet-gateway":
+		return fmt.Sprintf("terraform import aws_internet_gateway.%s %s", tfName, tracker.ID), true
+	case "route-table":
+		return fmt.Sprintf("terraform import aws_route_table.%s %s", tfName, tracker.ID), true
+	case "dhcp-options":
+		return fmt.Sprintf("terraform import aws_vpc_dhcp_options.%s %s", tfName, tracker.ID), true
+	case "volume":
+		return fmt.Sprintf("terraform import aws_ebs_volume.%s %s", tfName, tracker.ID), true
+	case resources.TypeElasticIp:
+		return fmt.Sprintf("terraform import aws_eip.%s %s", tfName, tracker.ID), true
+	case resources.TypeNatGateway:
+		return fmt.Sprintf("terraform import aws_nat_gateway.%s %s", tfName, tracker.ID), true
+	case "autoscaling-group":
+		// Autoscaling groups are imported by name
+		return fmt.Sprintf("terraform import aws_autoscaling_group.%s %s", tfName, tracker.ID), true
+	case resources.TypeAutoscalingLaunchConfig:
+		// The live launch configuration has a timestamp suffix that the
+		// terraform resource name does not
+		return fmt.Sprintf("terraform import aws_launch_configuration.%s %s", terraformName(stripTimestampSuffix(tracker.Name)), tracker.ID), true
+	case resources.TypeLoadBalancer:
+		// ELBs are imported by their (possibly truncated) load balancer name
+		return fmt.Sprintf("terraform import aws_elb.%s %s", tfName, tracker.ID), true
+	case "iam-role":
+		return fmt.Sprintf("terraform import aws_iam_role.%s %s", tfName, tracker.ID), true
+	case "iam-instance-profile":
+		return fmt.Sprintf("terraform import aws_iam_instance_profile.%s %s", tfName, tracker.ID), true
+	case "keypair":
+		return fmt.Sprintf("terraform import aws_key_pair.%s %s", tfName, tracker.ID), true
+
+	case "instance":
+		// Instances belong to their autoscaling groups
+		return "", true
+
+	default:
+		return "", false
+	}
+}
+
+// terraformName applies the same character substitutions as the terraform
+// target, which cannot use dots or slashes in resource names
+func terraformName(name string) string {
+	name = strings.Replace(name, ".", "-", -1)
+	name = strings.Replace(name, "/", "--", -1)
+	return name
+}
+
+// stripTimestampSuffix removes the creation-timestamp suffix kops appends to
+// launch configuration names
+func stripTimestampSuffix(name string) string {
+	i := strings.LastIndex(name, "-")
+	if i == -1 {
+		return name
+	}
+	suffix := name[i+1:]
+	for _, r := range suffix {
+		if r < '0' || r > '9' {
+			return name
+		}
+	}
+	return name[:i]
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/instancegroups"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_inventory_long = templates.LongDesc(i18n.T(`
+	Emit a normalized inventory of the clusters in the state store - clusters,
+	instance groups, running instances, versions, images and API endpoints - for
+	feeding CMDB and asset-tracking systems.
+
+	The inventory is printed as JSON or CSV, or pushed to a webhook as JSON.
+	Instances are enumerated from the cloud; pass --instances=false for a faster
+	inventory built from the state store alone.`))
+
+	toolbox_inventory_example = templates.Examples(i18n.T(`
+	# Inventory every cluster in the state store as JSON
+	kops toolbox inventory --state s3://my-state-store
+
+	# Inventory one cluster as CSV, without querying the cloud
+	kops toolbox inventory k8s-cluster.example.com --instances=false -o csv
+
+	# Push the inventory to an asset-tracking endpoint
+	kops toolbox inventory --webhook https://cmdb.example.com/ingest
+	`))
+
+	toolbox_inventory_short = i18n.T(`Export a cluster inventory for CMDB systems`)
+)
+
+// inventoryWebhookTimeout bounds the webhook delivery
+const inventoryWebhookTimeout = 30 * time.Second
+
+type ToolboxInventoryOptions struct {
+	Output string
+
+	// Instances enables enumerating running instances from the cloud
+	Instances bool
+
+	// Webhook is a URL the JSON inventory is POSTed to, instead of printing it
+	Webhook string
+
+	// ClusterNames restricts the inventory to the named clusters
+	ClusterNames []string
+}
+
+func (o *ToolboxInventoryOptions) InitDefaults() {
+	o.Output = OutputJSON
+	o.Instances = true
+}
+
+// clusterInventory is the inventory record for one cluster
+type clusterInventory struct {
+	Name              string `json:"name"`
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	CloudProvider     string `json:"cloudProvider,omitempty"`
+	Channel           string `json:"channel,omitempty"`
+	// APIEndpoint is the public API address of the cluster
+	APIEndpoint string `json:"apiEndpoint,omitempty"`
+	ConfigBase  string `json:"configBase,omitempty"`
+
+	InstanceGroups []*instanceGroupInventory `json:"instanceGroups,omitempty"`
+}
+
+// instanceGroupInventory is the inventory record for one instance group
+type instanceGroupInventory struct {
+	Name        string   `json:"name"`
+	Role        string   `json:"role,omitempty"`
+	MachineType string   `json:"machineType,omitempty"`
+	Image       string   `json:"image,omitempty"`
+	MinSize     int32    `json:"minSize,omitempty"`
+	MaxSize     int32    `json:"maxSize,omitempty"`
+	Subnets     []string `json:"subnets,omitempty"`
+
+	Instances []*instanceInventory `json:"instances,omitempty"`
+}
+
+// instanceInventory is the inventory record for one running instance
+type instanceInventory struct {
+	ID string `json:"id"`
+	// Status is Ready or NeedsUpdate, relative to the instance group's
+	// current configuration
+	Status string `json:"status,omitempty"`
+}
+
+func NewCmdToolboxInventory(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxInventoryOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:     "inventory [CLUSTER]...",
+		Short:   toolbox_inventory_short,
+		Long:    toolbox_inventory_long,
+		Example: toolbox_inventory_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.ClusterNames = args
+
+			err := RunToolboxInventory(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "output format.  One of: json, csv")
+	cmd.Flags().BoolVar(&options.Instances, "instances", options.Instances, "enumerate running instances from the cloud")
+	cmd.Flags().StringVar(&options.Webhook, "webhook", options.Webhook, "POST the JSON inventory to this URL instead of printing it")
+
+	return cmd
+}
+
+func RunToolboxInventory(f *util.Factory, out io.Writer, options *ToolboxInventoryOptions) error {
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	clusterList, err := clientset.ListClusters(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing clusters: %v", err)
+	}
+
+	requested := make(map[string]bool)
+	for _, name := range options.ClusterNames {
+		requested[name] = true
+	}
+
+	var inventory []*clusterInventory
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if len(requested) != 0 {
+			if !requested[cluster.ObjectMeta.Name] {
+				continue
+			}
+			delete(requested, cluster.ObjectMeta.Name)
+		}
+
+		record, err := buildClusterInventory(clientset, cluster, options.Instances)
+		if err != nil {
+			return err
+		}
+		inventory = append(inventory, record)
+	}
+
+	for name := range requested {
+		return fmt.Errorf("cluster not found %q", name)
+	}
+	if len(inventory) == 0 {
+		return fmt.Errorf("no clusters found")
+	}
+
+	if options.Webhook != "" {
+		if err := postInventory(options.Webhook, inventory); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Pushed inventory of %d cluster(s) to %s\n", len(inventory), options.Webhook)
+		return nil
+	}
+
+	switch options.Output {
+	case OutputJSON:
+		b, err := json.MarshalIndent(inventory, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling json: %v", err)
+		}
+		_, err = out.Write(b)
+		if err != nil {
+			return fmt.Errorf("error writing to stdout: %v", err)
+		}
+		fmt.Fprintf(out, "\n")
+		return nil
+
+	case "csv":
+		return writeInventoryCSV(out, inventory)
+
+	default:
+		return fmt.Errorf("Unsupported output format: %q", options.Output)
+	}
+}
+
+func buildClusterInventory(clientset simple.Clientset, cluster *api.Cluster, enumerateInstances bool) (*clusterInventory, error) {
+	igList, err := clientset.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	apiEndpoint := cluster.Spec.MasterPublicName
+	if apiEndpoint == "" {
+		apiEndpoint = "api." + cluster.ObjectMeta.Name
+	}
+
+	record := &clusterInventory{
+		Name:              cluster.ObjectMeta.Name,
+		KubernetesVersion: cluster.Spec.KubernetesVersion,
+		CloudProvider:     cluster.Spec.CloudProvider,
+		Channel:           cluster.Spec.Channel,
+		APIEndpoint:       "https://" + apiEndpoint,
+		ConfigBase:        cluster.Spec.ConfigBase,
+	}
+
+	var igs []*api.InstanceGroup
+	for i := range igList.Items {
+		ig := &igList.Items[i]
+		igs = append(igs, ig)
+		record.InstanceGroups = append(record.InstanceGroups, &instanceGroupInventory{
+			Name:        ig.ObjectMeta.Name,
+			Role:        string(ig.Spec.Role),
+			MachineType: ig.Spec.MachineType,
+			Image:       ig.Spec.Image,
+			MinSize:     fi.Int32Value(ig.Spec.MinSize),
+			MaxSize:     fi.Int32Value(ig.Spec.MaxSize),
+			Subnets:     ig.Spec.Subnets,
+		})
+	}
+
+	if enumerateInstances {
+		// Instance enumeration is best effort: a cluster on an unsupported
+		// cloud, or missing credentials, still gets a state-store inventory
+		if err := addInstanceInventory(cluster, igs, record); err != nil {
+			glog.Warningf("cannot enumerate instances for %q: %v", cluster.ObjectMeta.Name, err)
+		}
+	}
+
+	return record, nil
+}
+
+func addInstanceInventory(cluster *api.Cluster, igs []*api.InstanceGroup, record *clusterInventory) error {
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	groups, err := instancegroups.FindCloudInstanceGroups(cloud, cluster, igs, false, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, igRecord := range record.InstanceGroups {
+		group := groups[igRecord.Name]
+		if group == nil {
+			continue
+		}
+		for _, instance := range group.Ready {
+			igRecord.Instances = append(igRecord.Instances, &instanceInventory{
+				ID:     fi.StringValue(instance.ASGInstance.InstanceId),
+				Status: "Ready",
+			})
+		}
+		for _, instance := range group.NeedUpdate {
+			igRecord.Instances = append(igRecord.Instances, &instanceInventory{
+				ID:     fi.StringValue(instance.ASGInstance.InstanceId),
+				Status: "NeedsUpdate",
+			})
+		}
+	}
+	return nil
+}
+
+// writeInventoryCSV flattens the inventory to one row per instance, or one
+// row per instance group when instances were not enumerated
+func writeInventoryCSV(out io.Writer, inventory []*clusterInventory) error {
+	w := csv.NewWriter(out)
+	header := []string{"cluster", "kubernetesVersion", "cloudProvider", "apiEndpoint", "instanceGroup", "role", "machineType", "image", "minSize", "maxSize", "instanceID", "instanceStatus"}
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("error writing csv: %v", err)
+	}
+
+	for _, cluster := range inventory {
+		for _, ig := range cluster.InstanceGroups {
+			prefix := []string{
+				cluster.Name,
+				cluster.KubernetesVersion,
+				cluster.CloudProvider,
+				cluster.APIEndpoint,
+				ig.Name,
+				ig.Role,
+				ig.MachineType,
+				ig.Image,
+				strconv.Itoa(int(ig.MinSize)),
+				strconv.Itoa(int(ig.MaxSize)),
+			}
+
+			if len(ig.Instances) == 0 {
+				if err := w.Write(append(prefix, "", "")); err != nil {
+					return fmt.Errorf("error writing csv: %v", err)
+				}
+				continue
+			}
+			for _, instance := range ig.Instances {
+				if err := w.Write(append(prefix, instance.ID, instance.Status)); err != nil {
+					return fmt.Errorf("error writing csv: %v", err)
+				}
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func postInventory(url string, inventory []*clusterInventory) error {
+	b, err := json.Marshal(inventory)
+	if err != nil {
+		return fmt.Errorf("error marshaling json: %v", err)
+	}
+
+	client := &http.Client{Timeout: inventoryWebhookTimeout}
+	response, err := client.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("error posting inventory to %q: %v", url, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("error posting inventory to %q: status %q", url, response.Status)
+	}
+	return nil
+}
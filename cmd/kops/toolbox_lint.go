@@ -0,0 +1,113 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/deprecation"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_lint_long = templates.LongDesc(i18n.T(`
+	List every deprecated field in use across all clusters in the state store,
+	with the version the field is removed in and its replacement.`))
+
+	toolbox_lint_example = templates.Examples(i18n.T(`
+	# List deprecated fields in use across the fleet
+	kops toolbox lint --state s3://my-state-store
+	`))
+
+	toolbox_lint_short = i18n.T(`List deprecated fields in use across all clusters`)
+)
+
+func NewCmdToolboxLint(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lint",
+		Short:   toolbox_lint_short,
+		Long:    toolbox_lint_long,
+		Example: toolbox_lint_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunToolboxLint(f, out)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func RunToolboxLint(f *util.Factory, out io.Writer) error {
+	clientSet, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	clusterList, err := clientSet.ListClusters(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing clusters: %v", err)
+	}
+
+	var warnings []*deprecation.Warning
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+
+		igList, err := clientSet.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+		}
+		var instanceGroups []*api.InstanceGroup
+		for j := range igList.Items {
+			instanceGroups = append(instanceGroups, &igList.Items[j])
+		}
+
+		warnings = append(warnings, deprecation.FindDeprecations(cluster, instanceGroups)...)
+	}
+
+	if len(warnings) == 0 {
+		fmt.Fprintf(out, "No deprecated fields in use\n")
+		return nil
+	}
+
+	t := &tables.Table{}
+	t.AddColumn("KIND", func(w *deprecation.Warning) string {
+		return w.Kind
+	})
+	t.AddColumn("NAME", func(w *deprecation.Warning) string {
+		return w.Name
+	})
+	t.AddColumn("FIELD", func(w *deprecation.Warning) string {
+		return w.Field
+	})
+	t.AddColumn("REMOVED-IN", func(w *deprecation.Warning) string {
+		return w.RemoveInVersion
+	})
+	t.AddColumn("REPLACEMENT", func(w *deprecation.Warning) string {
+		return w.Replacement
+	})
+
+	return t.Render(warnings, out, "KIND", "NAME", "FIELD", "REMOVED-IN", "REPLACEMENT")
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_retag_long = templates.LongDesc(i18n.T(`
+	Reconciles the tags on the cluster's cloud resources with the cloudLabels in
+	the cluster and instance group specs, without running a full update.
+	Missing tags are added and stale kops-managed tags are removed.
+
+	Only AWS autoscaling groups are currently reconciled.`))
+
+	toolbox_retag_example = templates.Examples(i18n.T(`
+	# Preview tag changes for the cluster
+	kops toolbox retag --name k8s-cluster.example.com
+
+	# Apply the tag changes
+	kops toolbox retag --name k8s-cluster.example.com --yes
+	`))
+
+	toolbox_retag_short = i18n.T(`Reconcile cloud resource tags with the cluster spec`)
+)
+
+type ToolboxRetagOptions struct {
+	Yes bool
+
+	ClusterName string
+}
+
+func NewCmdToolboxRetag(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxRetagOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "retag",
+		Short:   toolbox_retag_short,
+		Long:    toolbox_retag_long,
+		Example: toolbox_retag_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := rootCommand.ProcessArgs(args); err != nil {
+				exitWithError(err)
+			}
+
+			options.ClusterName = rootCommand.ClusterName()
+
+			err := RunToolboxRetag(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Yes, "yes", options.Yes, "Apply the tag changes; without --yes only the planned changes are printed")
+
+	return cmd
+}
+
+func RunToolboxRetag(f *util.Factory, out io.Writer, options *ToolboxRetagOptions) error {
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	if options.ClusterName == "" {
+		return fmt.Errorf("ClusterName is required")
+	}
+
+	cluster, err := clientset.GetCluster(options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	list, err := clientset.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return fmt.Errorf("retag is only supported on AWS")
+	}
+
+	asgs, err := resources.FindAutoscalingGroups(awsCloud, awsCloud.Tags())
+	if err != nil {
+		return err
+	}
+
+	modelContext := &model.KopsModelContext{Cluster: cluster}
+
+	changes := 0
+	for i := range list.Items {
+		ig := &list.Items[i]
+
+		var asgName string
+		switch ig.Spec.Role {
+		case api.InstanceGroupRoleMaster:
+			asgName = ig.ObjectMeta.Name + ".masters." + cluster.ObjectMeta.Name
+		case api.InstanceGroupRoleNode, api.InstanceGroupRoleBastion:
+			asgName = ig.ObjectMeta.Name + "." + cluster.ObjectMeta.Name
+		default:
+			continue
+		}
+
+		var asg *autoscaling.Group
+		for _, g := range asgs {
+			if aws.StringValue(g.AutoScalingGroupName) == asgName {
+				asg = g
+				break
+			}
+		}
+		if asg == nil {
+			fmt.Fprintf(out, "InstanceGroup %q: no autoscaling group found (not yet created?)\n", ig.ObjectMeta.Name)
+			continue
+		}
+
+		expected, err := modelContext.CloudTagsForInstanceGroup(ig)
+		if err != nil {
+			return fmt.Errorf("error building cloud tags: %v", err)
+		}
+		expected[awsup.TagClusterName] = cluster.ObjectMeta.Name
+
+		actual := make(map[string]string)
+		for _, t := range asg.Tags {
+			actual[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+
+		var create []*autoscaling.Tag
+		for k, v := range expected {
+			if actual[k] == v {
+				continue
+			}
+			fmt.Fprintf(out, "InstanceGroup %q: will set tag %s=%s\n", ig.ObjectMeta.Name, k, v)
+			create = append(create, &autoscaling.Tag{
+				Key:               aws.String(k),
+				Value:             aws.String(v),
+				ResourceId:        asg.AutoScalingGroupName,
+				ResourceType:      aws.String("auto-scaling-group"),
+				PropagateAtLaunch: aws.Bool(true),
+			})
+		}
+
+		var remove []*autoscaling.Tag
+		for k := range actual {
+			if _, found := expected[k]; found {
+				continue
+			}
+			if !isKopsManagedTag(k) {
+				// Don't touch tags we didn't create (e.g. set by other tooling)
+				continue
+			}
+			fmt.Fprintf(out, "InstanceGroup %q: will remove stale tag %s\n", ig.ObjectMeta.Name, k)
+			remove = append(remove, &autoscaling.Tag{
+				Key:          aws.String(k),
+				ResourceId:   asg.AutoScalingGroupName,
+				ResourceType: aws.String("auto-scaling-group"),
+			})
+		}
+
+		changes += len(create) + len(remove)
+
+		if !options.Yes {
+			continue
+		}
+
+		if len(create) != 0 {
+			_, err := awsCloud.Autoscaling().CreateOrUpdateTags(&autoscaling.CreateOrUpdateTagsInput{Tags: create})
+			if err != nil {
+				return fmt.Errorf("error creating tags on %q: %v", asgName, err)
+			}
+		}
+		if len(remove) != 0 {
+			_, err := awsCloud.Autoscaling().DeleteTags(&autoscaling.DeleteTagsInput{Tags: remove})
+			if err != nil {
+				return fmt.Errorf("error removing tags on %q: %v", asgName, err)
+			}
+		}
+	}
+
+	if changes == 0 {
+		fmt.Fprintf(out, "Tags are already in sync for cluster %q\n", cluster.ObjectMeta.Name)
+	} else if !options.Yes {
+		fmt.Fprintf(out, "\nMust specify --yes to apply tag changes\n")
+	}
+
+	return nil
+}
+
+// isKopsManagedTag is true for tags that kops sets on instance groups,
+// and which it is therefore safe for us to remove when they become stale
+func isKopsManagedTag(key string) bool {
+	for _, prefix := range []string{model.NodeLabelPrefix, model.NodeTaintPrefix} {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,414 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+	extensionsv1beta1 "k8s.io/client-go/pkg/apis/extensions/v1beta1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_smoketest_long = templates.LongDesc(i18n.T(`
+	Deploy a small workload (Deployment, LoadBalancer Service, PersistentVolumeClaim
+	and a DNS probe pod) into its own namespace, verify that traffic, cluster DNS and
+	dynamic volume provisioning work end to end, then clean everything up.  This
+	exercises the data path that "kops validate cluster" - which only inspects
+	control-plane state - cannot see.`))
+
+	toolbox_smoketest_example = templates.Examples(i18n.T(`
+	# Run the smoke test against a cluster
+	kops toolbox smoke-test --name k8s-cluster.example.com
+	`))
+
+	toolbox_smoketest_short = i18n.T(`Run a self-contained end-to-end smoke test against a cluster`)
+)
+
+// smokeTestNamespace holds everything the smoke test creates, so cleanup is a
+// single namespace deletion
+const smokeTestNamespace = "kops-smoke-test"
+
+// smokeTestPollInterval is how often the smoke test rechecks a pending step
+const smokeTestPollInterval = 5 * time.Second
+
+type ToolboxSmokeTestOptions struct {
+	// Timeout bounds each step of the smoke test; load balancer provisioning
+	// and DNS propagation dominate
+	Timeout time.Duration
+
+	// SkipLoadBalancer skips the LoadBalancer Service step, for clusters
+	// whose cloud account cannot create load balancers
+	SkipLoadBalancer bool
+
+	// SkipPVC skips the volume provisioning step, for clusters without a
+	// default storage class
+	SkipPVC bool
+}
+
+func (o *ToolboxSmokeTestOptions) InitDefaults() {
+	o.Timeout = 10 * time.Minute
+}
+
+func NewCmdToolboxSmokeTest(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxSmokeTestOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:     "smoke-test",
+		Short:   toolbox_smoketest_short,
+		Long:    toolbox_smoketest_long,
+		Example: toolbox_smoketest_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = RunToolboxSmokeTest(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", options.Timeout, "Maximum time to wait for each step of the smoke test")
+	cmd.Flags().BoolVar(&options.SkipLoadBalancer, "skip-load-balancer", options.SkipLoadBalancer, "Skip the LoadBalancer Service step")
+	cmd.Flags().BoolVar(&options.SkipPVC, "skip-pvc", options.SkipPVC, "Skip the volume provisioning step")
+
+	return cmd
+}
+
+// smokeTestResult is the outcome of one smoke test step
+type smokeTestResult struct {
+	Name    string
+	Status  string
+	Message string
+}
+
+func RunToolboxSmokeTest(f *util.Factory, out io.Writer, options *ToolboxSmokeTestOptions) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+
+	contextName := cluster.ObjectMeta.Name
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{CurrentContext: contextName}).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("Cannot load kubecfg settings for %q: %v\n", contextName, err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("Cannot build kube api client for %q: %v\n", contextName, err)
+	}
+
+	if _, err := k8sClient.CoreV1().Namespaces().Get(smokeTestNamespace, metav1.GetOptions{}); err == nil {
+		return fmt.Errorf("namespace %q already exists; a previous smoke test did not clean up (delete the namespace to proceed)", smokeTestNamespace)
+	}
+
+	fmt.Fprintf(out, "Running smoke test against cluster %v in namespace %q\n\n", cluster.ObjectMeta.Name, smokeTestNamespace)
+
+	namespace := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: smokeTestNamespace},
+	}
+	if _, err := k8sClient.CoreV1().Namespaces().Create(namespace); err != nil {
+		return fmt.Errorf("error creating namespace %q: %v", smokeTestNamespace, err)
+	}
+	defer func() {
+		if err := k8sClient.CoreV1().Namespaces().Delete(smokeTestNamespace, &metav1.DeleteOptions{}); err != nil {
+			glog.Warningf("error deleting namespace %q: %v", smokeTestNamespace, err)
+		} else {
+			fmt.Fprintf(out, "\nCleaned up namespace %q\n", smokeTestNamespace)
+		}
+	}()
+
+	var results []smokeTestResult
+	results = append(results, smokeTestDeployment(k8sClient, options.Timeout))
+	results = append(results, smokeTestDNS(k8sClient, options.Timeout))
+	if !options.SkipPVC {
+		results = append(results, smokeTestPVC(k8sClient, options.Timeout))
+	}
+	if !options.SkipLoadBalancer {
+		results = append(results, smokeTestLoadBalancer(k8sClient, options.Timeout))
+	}
+
+	t := &tables.Table{}
+	t.AddColumn("STEP", func(r smokeTestResult) string {
+		return r.Name
+	})
+	t.AddColumn("STATUS", func(r smokeTestResult) string {
+		return r.Status
+	})
+	t.AddColumn("MESSAGE", func(r smokeTestResult) string {
+		return r.Message
+	})
+
+	fmt.Fprintln(out, "SMOKE TEST")
+	if err := t.Render(results, out, "STEP", "STATUS", "MESSAGE"); err != nil {
+		return fmt.Errorf("cannot render smoke test results: %v", err)
+	}
+
+	for _, r := range results {
+		if r.Status == conformanceFail {
+			return withExitCode(ExitCodeValidationFailed, fmt.Errorf("cluster %q failed the smoke test", cluster.ObjectMeta.Name))
+		}
+	}
+
+	fmt.Fprintf(out, "\nYour cluster %s passes the smoke test\n", cluster.ObjectMeta.Name)
+	return nil
+}
+
+// smokeTestWait polls the condition until it passes or the timeout elapses.
+// The condition returns a progress message, which becomes the failure message
+// on timeout.
+func smokeTestWait(timeout time.Duration, condition func() (bool, string)) (bool, string) {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, message := condition()
+		if done {
+			return true, message
+		}
+		if time.Now().After(deadline) {
+			return false, message
+		}
+		time.Sleep(smokeTestPollInterval)
+	}
+}
+
+// smokeTestDeployment creates a two-replica Deployment and waits for all
+// replicas to become available, which exercises scheduling, image pulls and
+// the kubelet end to end.
+func smokeTestDeployment(k8sClient kubernetes.Interface, timeout time.Duration) smokeTestResult {
+	result := smokeTestResult{Name: "deployment", Status: conformanceFail}
+
+	replicas := int32(2)
+	deployment := &extensionsv1beta1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "smoke-test",
+		},
+		Spec: extensionsv1beta1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "kops-smoke-test"},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "echoserver",
+							Image: "gcr.io/google_containers/echoserver:1.4",
+							Ports: []v1.ContainerPort{{ContainerPort: 8080}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if _, err := k8sClient.ExtensionsV1beta1().Deployments(smokeTestNamespace).Create(deployment); err != nil {
+		result.Message = fmt.Sprintf("error creating deployment: %v", err)
+		return result
+	}
+
+	ok, message := smokeTestWait(timeout, func() (bool, string) {
+		d, err := k8sClient.ExtensionsV1beta1().Deployments(smokeTestNamespace).Get("smoke-test", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("error getting deployment: %v", err)
+		}
+		if d.Status.AvailableReplicas < replicas {
+			return false, fmt.Sprintf("%d of %d replicas available", d.Status.AvailableReplicas, replicas)
+		}
+		return true, fmt.Sprintf("%d replicas available", replicas)
+	})
+
+	result.Message = message
+	if ok {
+		result.Status = conformancePass
+	}
+	return result
+}
+
+// smokeTestDNS runs a pod that resolves the kubernetes service name through
+// cluster DNS; the pod only succeeds if kube-dns answers.
+func smokeTestDNS(k8sClient kubernetes.Interface, timeout time.Duration) smokeTestResult {
+	result := smokeTestResult{Name: "dns", Status: conformanceFail}
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "smoke-test-dns",
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy: v1.RestartPolicyNever,
+			Containers: []v1.Container{
+				{
+					Name:    "nslookup",
+					Image:   "busybox:1.24",
+					Command: []string{"nslookup", "kubernetes.default.svc.cluster.local"},
+				},
+			},
+		},
+	}
+
+	if _, err := k8sClient.CoreV1().Pods(smokeTestNamespace).Create(pod); err != nil {
+		result.Message = fmt.Sprintf("error creating DNS probe pod: %v", err)
+		return result
+	}
+
+	ok, message := smokeTestWait(timeout, func() (bool, string) {
+		p, err := k8sClient.CoreV1().Pods(smokeTestNamespace).Get("smoke-test-dns", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("error getting DNS probe pod: %v", err)
+		}
+		switch p.Status.Phase {
+		case v1.PodSucceeded:
+			return true, "resolved kubernetes.default.svc.cluster.local"
+		case v1.PodFailed:
+			return true, "cannot resolve kubernetes.default.svc.cluster.local"
+		default:
+			return false, fmt.Sprintf("DNS probe pod is %s", p.Status.Phase)
+		}
+	})
+
+	result.Message = message
+	if ok && message == "resolved kubernetes.default.svc.cluster.local" {
+		result.Status = conformancePass
+	}
+	return result
+}
+
+// smokeTestPVC creates a PersistentVolumeClaim and waits for the default
+// storage class to provision and bind a volume.
+func smokeTestPVC(k8sClient kubernetes.Interface, timeout time.Duration) smokeTestResult {
+	result := smokeTestResult{Name: "volume-provisioning", Status: conformanceFail}
+
+	pvc := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "smoke-test",
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+
+	if _, err := k8sClient.CoreV1().PersistentVolumeClaims(smokeTestNamespace).Create(pvc); err != nil {
+		result.Message = fmt.Sprintf("error creating PVC: %v", err)
+		return result
+	}
+
+	ok, message := smokeTestWait(timeout, func() (bool, string) {
+		p, err := k8sClient.CoreV1().PersistentVolumeClaims(smokeTestNamespace).Get("smoke-test", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("error getting PVC: %v", err)
+		}
+		if p.Status.Phase != v1.ClaimBound {
+			return false, fmt.Sprintf("PVC is %s", p.Status.Phase)
+		}
+		return true, fmt.Sprintf("bound to volume %q", p.Spec.VolumeName)
+	})
+
+	result.Message = message
+	if ok {
+		result.Status = conformancePass
+	}
+	return result
+}
+
+// smokeTestLoadBalancer exposes the Deployment through a LoadBalancer Service
+// and fetches a page through the provisioned load balancer, which exercises
+// the cloud provider integration and the traffic path from outside the
+// cluster.
+func smokeTestLoadBalancer(k8sClient kubernetes.Interface, timeout time.Duration) smokeTestResult {
+	result := smokeTestResult{Name: "load-balancer", Status: conformanceFail}
+
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "smoke-test",
+		},
+		Spec: v1.ServiceSpec{
+			Type:     v1.ServiceTypeLoadBalancer,
+			Selector: map[string]string{"app": "kops-smoke-test"},
+			Ports: []v1.ServicePort{
+				{
+					Port:       80,
+					TargetPort: intstr.FromInt(8080),
+				},
+			},
+		},
+	}
+
+	if _, err := k8sClient.CoreV1().Services(smokeTestNamespace).Create(service); err != nil {
+		result.Message = fmt.Sprintf("error creating service: %v", err)
+		return result
+	}
+
+	ok, message := smokeTestWait(timeout, func() (bool, string) {
+		s, err := k8sClient.CoreV1().Services(smokeTestNamespace).Get("smoke-test", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("error getting service: %v", err)
+		}
+
+		ingress := s.Status.LoadBalancer.Ingress
+		if len(ingress) == 0 {
+			return false, "waiting for load balancer provisioning"
+		}
+		address := ingress[0].Hostname
+		if address == "" {
+			address = ingress[0].IP
+		}
+
+		// The load balancer's DNS name can take a while to resolve and its
+		// targets a while to pass health checks; keep retrying until the
+		// timeout
+		response, err := http.Get("http://" + address)
+		if err != nil {
+			return false, fmt.Sprintf("load balancer %q not serving yet: %v", address, err)
+		}
+		defer response.Body.Close()
+		if response.StatusCode != 200 {
+			return false, fmt.Sprintf("load balancer %q returned status %q", address, response.Status)
+		}
+		return true, fmt.Sprintf("fetched http://%s through the load balancer", address)
+	})
+
+	result.Message = message
+	if ok {
+		result.Status = conformancePass
+	}
+	return result
+}
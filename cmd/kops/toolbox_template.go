@@ -0,0 +1,225 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/diff"
+	"k8s.io/kops/util/pkg/vfs"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	toolbox_template_long = templates.LongDesc(i18n.T(`
+	Renders a go template containing Cluster and InstanceGroup specifications,
+	substituting values from the supplied values files.
+
+	With --dry-run, instead of printing the rendered manifest, each rendered object
+	is compared against the object currently in the state store and a diff is
+	printed, so templated GitOps pipelines can produce meaningful previews.`))
+
+	toolbox_template_example = templates.Examples(i18n.T(`
+	# Render a cluster template
+	kops toolbox template --template cluster.tmpl.yaml --values values.yaml
+
+	# Show the diff against the state store without applying anything
+	kops toolbox template --template cluster.tmpl.yaml --values values.yaml --dry-run
+	`))
+
+	toolbox_template_short = i18n.T(`Render a cluster template, optionally diffing against the state store`)
+)
+
+type ToolboxTemplateOptions struct {
+	Template string
+	Values   []string
+	DryRun   bool
+}
+
+func NewCmdToolboxTemplate(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxTemplateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "template",
+		Short:   toolbox_template_short,
+		Long:    toolbox_template_long,
+		Example: toolbox_template_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunToolboxTemplate(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Template, "template", options.Template, "Path to the template file")
+	cmd.Flags().StringSliceVar(&options.Values, "values", options.Values, "A list of yaml files containing template values")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", options.DryRun, "Diff the rendered objects against the state store instead of printing them")
+	cmd.MarkFlagRequired("template")
+
+	return cmd
+}
+
+func RunToolboxTemplate(f *util.Factory, out io.Writer, options *ToolboxTemplateOptions) error {
+	if options.Template == "" {
+		return fmt.Errorf("template is required")
+	}
+
+	// Merge the values files, later files overriding earlier ones
+	values := make(map[string]interface{})
+	for _, p := range options.Values {
+		contents, err := vfs.Context.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("error reading values file %q: %v", p, err)
+		}
+		v := make(map[string]interface{})
+		if err := yaml.Unmarshal(contents, &v); err != nil {
+			return fmt.Errorf("error parsing values file %q: %v", p, err)
+		}
+		for key, value := range v {
+			values[key] = value
+		}
+	}
+
+	contents, err := vfs.Context.ReadFile(options.Template)
+	if err != nil {
+		return fmt.Errorf("error reading template %q: %v", options.Template, err)
+	}
+
+	t, err := template.New(options.Template).Option("missingkey=error").Parse(string(contents))
+	if err != nil {
+		return fmt.Errorf("error parsing template %q: %v", options.Template, err)
+	}
+
+	var rendered bytes.Buffer
+	if err := t.Execute(&rendered, values); err != nil {
+		return fmt.Errorf("error rendering template %q: %v", options.Template, err)
+	}
+
+	if !options.DryRun {
+		_, err := out.Write(rendered.Bytes())
+		return err
+	}
+
+	clientset, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	codec := kopsapi.Codecs.UniversalDecoder(kopsapi.SchemeGroupVersion)
+
+	sections := bytes.Split(rendered.Bytes(), []byte("\n---\n"))
+	for _, section := range sections {
+		if len(bytes.TrimSpace(section)) == 0 {
+			continue
+		}
+
+		o, gvk, err := codec.Decode(section, nil, nil)
+		if err != nil {
+			return fmt.Errorf("error parsing rendered template: %v", err)
+		}
+
+		switch v := o.(type) {
+		case *kopsapi.Cluster:
+			existing, err := clientset.GetCluster(v.ObjectMeta.Name)
+			if err != nil {
+				return fmt.Errorf("error fetching cluster %q: %v", v.ObjectMeta.Name, err)
+			}
+			// Avoid passing a typed nil into the interface
+			var existingObj interface{}
+			if existing != nil {
+				existingObj = existing
+			}
+			if err := printObjectDiff(out, "Cluster/"+v.ObjectMeta.Name, existingObj, v); err != nil {
+				return err
+			}
+
+		case *kopsapi.InstanceGroup:
+			clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+			if clusterName == "" {
+				return fmt.Errorf("must specify %q label with cluster name on instanceGroup %q", kopsapi.LabelClusterName, v.ObjectMeta.Name)
+			}
+			cluster, err := clientset.GetCluster(clusterName)
+			if err != nil {
+				return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
+			}
+			var existingObj interface{}
+			if cluster != nil {
+				existing, err := clientset.InstanceGroupsFor(cluster).Get(v.ObjectMeta.Name, metav1.GetOptions{})
+				if err != nil {
+					return fmt.Errorf("error fetching instanceGroup %q: %v", v.ObjectMeta.Name, err)
+				}
+				if existing != nil {
+					existingObj = existing
+				}
+			}
+			if err := printObjectDiff(out, "InstanceGroup/"+v.ObjectMeta.Name, existingObj, v); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("Unhandled kind %q in template", gvk)
+		}
+	}
+
+	return nil
+}
+
+// printObjectDiff prints a unified diff of the state store object against the rendered object.
+// Either object may be nil; a nil existing object means the rendered object is new.
+func printObjectDiff(out io.Writer, name string, existing, rendered interface{}) error {
+	existingYaml := ""
+	if existing != nil {
+		b, err := yaml.Marshal(existing)
+		if err != nil {
+			return fmt.Errorf("error marshaling existing %s: %v", name, err)
+		}
+		existingYaml = string(b)
+	}
+
+	renderedYaml := ""
+	if rendered != nil {
+		b, err := yaml.Marshal(rendered)
+		if err != nil {
+			return fmt.Errorf("error marshaling rendered %s: %v", name, err)
+		}
+		renderedYaml = string(b)
+	}
+
+	if existingYaml == renderedYaml {
+		fmt.Fprintf(out, "%s: no changes\n", name)
+		return nil
+	}
+
+	if existingYaml == "" {
+		fmt.Fprintf(out, "%s: will be created\n", name)
+		return nil
+	}
+
+	fmt.Fprintf(out, "%s:\n", name)
+	fmt.Fprintf(out, "%s\n", diff.FormatDiff(existingYaml, renderedYaml))
+	return nil
+}
@@ -31,9 +31,12 @@ import (
 	"k8s.io/kops/cmd/kops/util"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/pkg/deprecation"
+	"k8s.io/kops/pkg/instancegroups"
 	"k8s.io/kops/pkg/kubeconfig"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/fi/utils"
 	"k8s.io/kops/upup/pkg/kutil"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
@@ -126,6 +129,12 @@ func RunUpdateCluster(f *util.Factory, clusterName string, out io.Writer, c *Upd
 		targetName = cloudup.TargetDryRun
 	}
 
+	if !isDryrun {
+		if err := rootCommand.AssumeRoleForMutation(); err != nil {
+			return err
+		}
+	}
+
 	if c.OutDir == "" {
 		if c.Target == cloudup.TargetTerraform {
 			c.OutDir = "out/terraform"
@@ -138,7 +147,7 @@ func RunUpdateCluster(f *util.Factory, clusterName string, out io.Writer, c *Upd
 
 	cluster, err := GetCluster(f, clusterName)
 	if err != nil {
-		return err
+		return withExitCode(ExitCodeConfigError, err)
 	}
 
 	keyStore, err := registry.KeyStore(cluster)
@@ -173,16 +182,58 @@ func RunUpdateCluster(f *util.Factory, clusterName string, out io.Writer, c *Upd
 	}
 
 	var instanceGroups []*kops.InstanceGroup
+	var expiredGroups []*kops.InstanceGroup
 	{
 		list, err := clientset.InstanceGroupsFor(cluster).List(metav1.ListOptions{})
 		if err != nil {
 			return err
 		}
 		for i := range list.Items {
-			instanceGroups = append(instanceGroups, &list.Items[i])
+			ig := &list.Items[i]
+			if ig.Spec.ExpiresAt != nil && ig.Spec.ExpiresAt.Time.Before(time.Now()) {
+				expiredGroups = append(expiredGroups, ig)
+			} else {
+				instanceGroups = append(instanceGroups, ig)
+			}
+		}
+	}
+
+	// Expired instance groups are excluded from the apply, and deleted when we are applying changes
+	if len(expiredGroups) != 0 {
+		if isDryrun {
+			for _, ig := range expiredGroups {
+				fmt.Fprintf(out, "InstanceGroup %q expired at %v; will be deleted\n", ig.ObjectMeta.Name, ig.Spec.ExpiresAt.Time)
+			}
+		} else {
+			cloud, err := cloudup.BuildCloud(cluster)
+			if err != nil {
+				return err
+			}
+
+			for _, ig := range expiredGroups {
+				fmt.Fprintf(out, "InstanceGroup %q expired at %v; deleting\n", ig.ObjectMeta.Name, ig.Spec.ExpiresAt.Time)
+
+				d := &instancegroups.DeleteInstanceGroup{}
+				d.Cluster = cluster
+				d.Cloud = cloud
+				d.Clientset = clientset
+
+				if err := d.DeleteInstanceGroup(ig); err != nil {
+					return withExitCode(ExitCodeCloudError, fmt.Errorf("error deleting expired InstanceGroup %q: %v", ig.ObjectMeta.Name, err))
+				}
+			}
 		}
 	}
 
+	// Check spot pricing before applying, so capacity problems are visible up front
+	if err := warnSpotCapacity(cluster, instanceGroups, out); err != nil {
+		glog.Warningf("error checking spot pricing: %v", err)
+	}
+
+	for _, warning := range deprecation.FindDeprecations(cluster, instanceGroups) {
+		fmt.Fprintf(out, "Warning: %s\n", warning)
+	}
+
 	applyCmd := &cloudup.ApplyClusterCmd{
 		Cluster:         cluster,
 		Models:          strings.Split(c.Models, ","),
@@ -196,7 +247,7 @@ func RunUpdateCluster(f *util.Factory, clusterName string, out io.Writer, c *Upd
 
 	err = applyCmd.Run()
 	if err != nil {
-		return err
+		return withExitCode(ExitCodeCloudError, err)
 	}
 
 	if isDryrun {
@@ -312,6 +363,55 @@ func RunUpdateCluster(f *util.Factory, clusterName string, out io.Writer, c *Upd
 	return nil
 }
 
+// warnSpotCapacity prints a warning for each spot instance group whose bid is at or
+// below the current spot price in one of its zones
+func warnSpotCapacity(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, out io.Writer) error {
+	var spotGroups []*kops.InstanceGroup
+	for _, ig := range instanceGroups {
+		if fi.StringValue(ig.Spec.MaxPrice) != "" {
+			spotGroups = append(spotGroups, ig)
+		}
+	}
+	if len(spotGroups) == 0 {
+		return nil
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		// Spot pricing is only checked on AWS
+		return nil
+	}
+
+	zoneForSubnet := make(map[string]string)
+	for i := range cluster.Spec.Subnets {
+		subnet := &cluster.Spec.Subnets[i]
+		zoneForSubnet[subnet.Name] = subnet.Zone
+	}
+
+	for _, ig := range spotGroups {
+		var zones []string
+		for _, subnetName := range ig.Spec.Subnets {
+			if zone := zoneForSubnet[subnetName]; zone != "" {
+				zones = append(zones, zone)
+			}
+		}
+
+		warnings, err := awsup.CheckSpotPricing(awsCloud, ig.Spec.MachineType, fi.StringValue(ig.Spec.MaxPrice), zones)
+		if err != nil {
+			return err
+		}
+		for _, w := range warnings {
+			fmt.Fprintf(out, "Warning: InstanceGroup %q: %s\n", ig.ObjectMeta.Name, w)
+		}
+	}
+
+	return nil
+}
+
 func usesBastion(instanceGroups []*kops.InstanceGroup) bool {
 	for _, ig := range instanceGroups {
 		if ig.Spec.Role == kops.InstanceGroupRoleBastion {
@@ -54,6 +54,7 @@ func NewCmdValidate(f *util.Factory, out io.Writer) *cobra.Command {
 
 	// create subcommands
 	cmd.AddCommand(NewCmdValidateCluster(f, out))
+	cmd.AddCommand(NewCmdValidateServe(f, out))
 
 	return cmd
 }
@@ -17,11 +17,21 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,17 +40,114 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/kops/cmd/kops/util"
 	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/registry"
 	apiutil "k8s.io/kops/pkg/apis/kops/util"
+	"k8s.io/kops/pkg/resources"
 	"k8s.io/kops/pkg/validation"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/util/pkg/tables"
 )
 
+// OutputPrometheus renders the validation result in the prometheus textfile collector format
+const OutputPrometheus = "prometheus"
+
+// validateWaitInterval is how often validation is retried under --wait
+const validateWaitInterval = 10 * time.Second
+
 type ValidateClusterOptions struct {
-	// No options yet
+	// Output is the output format: table, json, yaml or prometheus
+	Output string
+
+	// Tolerance is the worker node failure budget, either a count ("2") or a
+	// percentage of the expected nodes ("10%").  Empty means no budget.
+	Tolerance string
+
+	// Diagnose enables deep diagnosis of machines that have not joined the cluster
+	Diagnose bool
+
+	// FailOn is the minimum severity that fails validation: "error" (the
+	// default) ignores warnings, "warning" fails on them too
+	FailOn string
+
+	// Wait keeps re-validating until the cluster passes or this much time
+	// has elapsed; zero validates once
+	Wait time.Duration
+
+	// PodNamespaces are extra namespaces whose pods must be healthy for
+	// validation to pass, in addition to kube-system
+	PodNamespaces []string
+
+	// PodSelector restricts which pods in PodNamespaces are validated
+	PodSelector string
+
+	// SkipChecks disables the named validation checks
+	SkipChecks []string
+
+	// Full includes the full node objects in json/yaml output; the default
+	// is the compact per-node summary, which stays a reasonable size on
+	// large clusters
+	Full bool
+
+	// SaveHistory writes the validation result as a timestamped record in
+	// the cluster's state store, for later inspection with
+	// kops get validation-history
+	SaveHistory bool
+
+	// Serve runs validation continuously and exposes the results as
+	// prometheus metrics on this address (e.g. ":9090"); empty validates
+	// once and exits
+	Serve string
+
+	// Interval is how often validation reruns in --serve mode
+	Interval time.Duration
+
+	// InstanceGroups restricts node validation to the named instance groups
+	InstanceGroups []string
+
+	// InstanceGroupRoles restricts node validation to groups with these roles
+	InstanceGroupRoles []string
+
+	// IgnorePodSelectors excludes pods matching these label selectors from
+	// validation, for known-broken addons that should not block operations
+	IgnorePodSelectors []string
+
+	// CertExpiryWindow is how far ahead certificate expiry is reported as a
+	// warning; zero disables the certificate check
+	CertExpiryWindow time.Duration
+
+	// DNSCheck actively exercises in-cluster service DNS with a probe pod;
+	// off by default because it creates a pod in kube-system
+	DNSCheck bool
+
+	// DNSCheckTarget is the name the DNS probe resolves
+	DNSCheckTarget string
+
+	// DNSProbeSelector selects existing probe pods in kube-system to check
+	// instead of creating one
+	DNSProbeSelector string
+
+	// Notify lists sinks that receive the result when failures are found:
+	// "events" (a Kubernetes Event in kube-system), "slack:<url>" or
+	// "webhook:<url>"
+	Notify []string
+
+	// DiffAgainst is a previously saved validation result to compare with;
+	// only new and resolved failures are reported, so pipelines can gate on
+	// "no regressions" rather than a fully green cluster
+	DiffAgainst string
+}
+
+func (o *ValidateClusterOptions) InitDefaults() {
+	o.Output = OutputTable
+	o.FailOn = validation.SeverityError
+	o.Interval = time.Minute
+	o.CertExpiryWindow = validation.DefaultCertificateExpiryWindow
+	o.DNSCheckTarget = "kubernetes.default.svc.cluster.local"
 }
 
 func NewCmdValidateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	options := &ValidateClusterOptions{}
+	options.InitDefaults()
 
 	cmd := &cobra.Command{
 		Use:     "cluster",
@@ -55,6 +162,28 @@ func NewCmdValidateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "output format.  One of: table, json, yaml, prometheus")
+	cmd.Flags().StringVar(&options.Tolerance, "tolerance", options.Tolerance, "Number (\"2\") or percentage (\"10%\") of not-ready nodes to tolerate as an acceptable failure budget")
+	cmd.Flags().BoolVar(&options.Diagnose, "diagnose", options.Diagnose, "On validation failure, inspect the cloud instances that have not joined the cluster and report a probable cause (AWS only)")
+	cmd.Flags().StringVar(&options.FailOn, "fail-on", options.FailOn, "Minimum severity that fails validation.  One of: error, warning")
+	cmd.Flags().DurationVar(&options.Wait, "wait", options.Wait, "Amount of time to keep re-validating until the cluster passes (0 validates once)")
+	cmd.Flags().StringSliceVar(&options.PodNamespaces, "pod-namespace", options.PodNamespaces, "Additional namespaces whose pods must be healthy for validation to pass")
+	cmd.Flags().StringVar(&options.PodSelector, "pod-selector", options.PodSelector, "Label selector restricting which pods in the --pod-namespace namespaces are validated")
+	cmd.Flags().BoolVar(&options.Full, "full", options.Full, "Include the full node objects in json/yaml output instead of the compact per-node summary")
+	cmd.Flags().StringSliceVar(&options.SkipChecks, "skip-check", options.SkipChecks, "Validation checks to skip by name (e.g. components, pods, apiserver-health, etcd)")
+	cmd.Flags().BoolVar(&options.SaveHistory, "save-history", options.SaveHistory, "Save the validation result as a timestamped record in the state store (see kops get validation-history)")
+	cmd.Flags().StringVar(&options.Serve, "serve", options.Serve, "Run validation continuously and serve the results as prometheus metrics on this address (e.g. \":9090\")")
+	cmd.Flags().DurationVar(&options.Interval, "interval", options.Interval, "How often validation reruns in --serve mode")
+	cmd.Flags().StringSliceVar(&options.InstanceGroups, "instance-group", options.InstanceGroups, "Instance groups to validate (defaults to all); node failures in other groups are ignored")
+	cmd.Flags().StringSliceVar(&options.InstanceGroupRoles, "instance-group-role", options.InstanceGroupRoles, "Instance group roles to validate (Master, Node or Bastion)")
+	cmd.Flags().StringSliceVar(&options.IgnorePodSelectors, "ignore-pod-selector", options.IgnorePodSelectors, "Label selectors for unhealthy pods to exclude from validation (exclusions are logged)")
+	cmd.Flags().DurationVar(&options.CertExpiryWindow, "cert-expiry-window", options.CertExpiryWindow, "Report certificates expiring within this window as warnings (0 disables the check)")
+	cmd.Flags().BoolVar(&options.DNSCheck, "dns-check", options.DNSCheck, "Actively check in-cluster service DNS by running an nslookup probe pod in kube-system")
+	cmd.Flags().StringVar(&options.DNSCheckTarget, "dns-check-target", options.DNSCheckTarget, "DNS name the --dns-check probe resolves")
+	cmd.Flags().StringVar(&options.DNSProbeSelector, "dns-probe-selector", options.DNSProbeSelector, "With --dns-check, check existing probe pods in kube-system matching this label selector instead of creating one")
+	cmd.Flags().StringSliceVar(&options.Notify, "notify", options.Notify, "Sinks notified when failures are found: events, slack:<url> or webhook:<url>")
+	cmd.Flags().StringVar(&options.DiffAgainst, "diff-against", options.DiffAgainst, "Previously saved validation result (json or yaml) to diff with; fails only on new failures")
+
 	return cmd
 }
 
@@ -66,7 +195,7 @@ func RunValidateCluster(f *util.Factory, cmd *cobra.Command, args []string, out
 
 	cluster, err := rootCommand.Cluster()
 	if err != nil {
-		return err
+		return withExitCode(ExitCodeConfigError, err)
 	}
 
 	clientSet, err := f.Clientset()
@@ -79,7 +208,44 @@ func RunValidateCluster(f *util.Factory, cmd *cobra.Command, args []string, out
 		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
 	}
 
-	fmt.Fprintf(out, "Validating cluster %v\n\n", cluster.ObjectMeta.Name)
+	switch options.Output {
+	case OutputTable, OutputJSON, OutputYaml, OutputPrometheus:
+	default:
+		return withExitCode(ExitCodeConfigError, fmt.Errorf("Unsupported output format: %q", options.Output))
+	}
+
+	switch options.FailOn {
+	case validation.SeverityError, validation.SeverityWarning:
+	default:
+		return fmt.Errorf("Unsupported --fail-on severity: %q", options.FailOn)
+	}
+
+	if options.PodSelector != "" && len(options.PodNamespaces) == 0 {
+		return fmt.Errorf("--pod-selector requires --pod-namespace")
+	}
+	for _, namespace := range options.PodNamespaces {
+		validation.RegisterPodValidationScope(validation.PodValidationScope{Namespace: namespace, Selector: options.PodSelector})
+	}
+	for _, name := range options.SkipChecks {
+		validation.SkipValidationCheck(name)
+	}
+	for _, selector := range options.IgnorePodSelectors {
+		if err := validation.RegisterPodIgnoreSelector(selector); err != nil {
+			return err
+		}
+	}
+
+	if len(options.InstanceGroups) != 0 || len(options.InstanceGroupRoles) != 0 {
+		scope, err := filterInstanceGroups(list, options.InstanceGroups, options.InstanceGroupRoles)
+		if err != nil {
+			return err
+		}
+		validation.ScopeValidationToInstanceGroups(scope)
+	}
+
+	if options.Output == OutputTable {
+		fmt.Fprintf(out, "Validating cluster %v\n\n", cluster.ObjectMeta.Name)
+	}
 
 	var instanceGroups []api.InstanceGroup
 	for _, ig := range list.Items {
@@ -100,16 +266,205 @@ func RunValidateCluster(f *util.Factory, cmd *cobra.Command, args []string, out
 		return fmt.Errorf("Cannot load kubecfg settings for %q: %v\n", contextName, err)
 	}
 
+	// Protobuf halves the decode cost and allocation of listing thousands of nodes
+	config.ContentType = "application/vnd.kubernetes.protobuf"
+
 	k8sClient, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return fmt.Errorf("Cannot build kube api client for %q: %v\n", contextName, err)
 	}
 
-	validationCluster, validationFailed := validation.ValidateCluster(cluster.ObjectMeta.Name, list, k8sClient)
+	tolerance, err := parseTolerance(options.Tolerance)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range options.Notify {
+		if err := validation.CheckNotifyTarget(target); err != nil {
+			return err
+		}
+	}
+
+	// The expected control-plane version comes from the cluster spec, which
+	// only the command knows about
+	if cluster.Spec.KubernetesVersion != "" {
+		validation.RegisterValidationCheck(&validation.ComponentVersionCheck{KubernetesVersion: cluster.Spec.KubernetesVersion})
+		validation.RegisterValidationCheck(&validation.KubeletVersionCheck{KubernetesVersion: cluster.Spec.KubernetesVersion})
+	}
+
+	// Probe every declared API endpoint, not just the one the kubeconfig uses
+	apiEndpoints := []string{cluster.Spec.MasterPublicName}
+	if cluster.Spec.MasterInternalName != cluster.Spec.MasterPublicName {
+		apiEndpoints = append(apiEndpoints, cluster.Spec.MasterInternalName)
+	}
+	validation.RegisterValidationCheck(&validation.APIEndpointCheck{Endpoints: apiEndpoints})
+
+	if options.DNSCheck {
+		validation.RegisterValidationCheck(&validation.DNSResolutionCheck{
+			Target:              options.DNSCheckTarget,
+			ExistingPodSelector: options.DNSProbeSelector,
+		})
+	}
+
+	if cluster.Spec.Validation != nil && len(cluster.Spec.Validation.MetricGates) != 0 {
+		validation.RegisterValidationCheck(&validation.MetricGatesCheck{Gates: cluster.Spec.Validation.MetricGates})
+	}
+
+	if options.Serve != "" {
+		if options.Wait > 0 {
+			return fmt.Errorf("--serve cannot be combined with --wait")
+		}
+		return serveValidationMetrics(options.Serve, options.Interval, cluster.ObjectMeta.Name, list, k8sClient, tolerance)
+	}
+
+	var validationCluster *validation.ValidationCluster
+	var validationFailed error
+	validationStart := time.Now()
+	if options.Wait > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), options.Wait)
+		defer cancel()
+
+		validator := validation.NewClusterValidator(cluster.ObjectMeta.Name, list, k8sClient, tolerance)
+		validationCluster, validationFailed = validator.ValidateWithDeadline(ctx, validateWaitInterval, 1)
+	} else {
+		validationCluster, validationFailed = validation.ValidateClusterWithTolerance(cluster.ObjectMeta.Name, list, k8sClient, tolerance)
+	}
+
+	// Certificate expiry is invisible to the in-cluster checks; inspect the
+	// keystore and the served certificates directly
+	if validationCluster != nil && options.CertExpiryWindow > 0 {
+		keyStore, err := registry.KeyStore(cluster)
+		if err != nil {
+			return err
+		}
+		certFailures, err := validation.CollectCertificateExpiryFailures(keyStore, apiEndpoints, options.CertExpiryWindow)
+		if err != nil {
+			glog.Warningf("cannot check certificate expiry: %v", err)
+		} else {
+			validationCluster.AddCertificateFailures(certFailures)
+		}
+	}
+
+	// Cloud resources tagged for the cluster but not part of any instance
+	// group are the inverse of "missing from the cloud provider"; they cost
+	// money and can interfere with rolling updates
+	var orphanResources []*resources.OrphanResource
+	if validationCluster != nil {
+		var igs []*api.InstanceGroup
+		for i := range list.Items {
+			igs = append(igs, &list.Items[i])
+		}
+
+		cloud, err := cloudup.BuildCloud(cluster)
+		if err != nil {
+			glog.Warningf("cannot check for orphaned resources: %v", err)
+		} else {
+			orphans, err := resources.FindOrphanResources(cloud, cluster, igs)
+			if err != nil {
+				glog.Warningf("cannot check for orphaned resources: %v", err)
+			} else {
+				orphanResources = orphans
+				var failures []string
+				for _, orphan := range orphans {
+					failures = append(failures, fmt.Sprintf("%s %q: %s", orphan.Type, orphan.ID, orphan.Reason))
+				}
+				validationCluster.AddOrphanResourceFailures(failures)
+			}
+		}
+	}
+
+	if validationFailed == nil && options.FailOn == validation.SeverityWarning && validationCluster != nil && validationCluster.HasWarnings() {
+		validationFailed = fmt.Errorf("cluster %s has validation warnings and --fail-on=warning is set", cluster.ObjectMeta.Name)
+	}
+
+	// Check the health of the masters as seen by the API load balancer, if there is one;
+	// an instance can be InService in the ASG but failing the load balancer health check
+	if validationCluster != nil && cluster.Spec.API != nil && cluster.Spec.API.LoadBalancer != nil {
+		cloud, err := cloudup.BuildCloud(cluster)
+		if err != nil {
+			return err
+		}
+
+		lbFailures, err := validation.CollectAPILoadBalancerHealthFailures(cluster, cloud)
+		if err != nil {
+			glog.Warningf("cannot check API load balancer health: %v", err)
+		} else if len(lbFailures) != 0 {
+			validationCluster.AddLoadBalancerFailures(lbFailures)
+			if validationFailed == nil {
+				validationFailed = fmt.Errorf("API load balancer targets are NOT healthy %s", cluster.ObjectMeta.Name)
+			}
+		}
+	}
+
+	if options.SaveHistory && validationCluster != nil {
+		configBase, err := registry.ConfigBase(cluster)
+		if err != nil {
+			return err
+		}
+		if err := validation.WriteValidationRecord(configBase, validationCluster); err != nil {
+			glog.Warningf("cannot save validation record: %v", err)
+		}
+	}
+
+	// Notification sinks only hear about broken clusters; a green validation
+	// is not news.  A failing sink is logged but does not change the result.
+	if validationCluster != nil && len(validationCluster.Failures) != 0 {
+		for _, target := range options.Notify {
+			if err := validation.NotifyValidationFailures(target, validationCluster, k8sClient); err != nil {
+				glog.Warningf("cannot notify %q: %v", target, err)
+			}
+		}
+	}
 
 	if validationCluster == nil || validationCluster.NodeList == nil || validationCluster.NodeList.Items == nil {
 		// validationFailed error is already formatted
-		return validationFailed
+		return withExitCode(ExitCodeValidationFailed, validationFailed)
+	}
+
+	if options.DiffAgainst != "" {
+		if validationCluster == nil {
+			return withExitCode(ExitCodeValidationFailed, validationFailed)
+		}
+		return runValidationDiff(out, options.DiffAgainst, validationCluster)
+	}
+
+	if options.Output == OutputPrometheus {
+		// Cluster state is reported through the metrics; a failed validation is not an error here
+		return writeValidationMetrics(out, cluster.ObjectMeta.Name, validationCluster, validationFailed, time.Since(validationStart))
+	}
+
+	if options.Output == OutputJSON || options.Output == OutputYaml {
+		result := validationCluster
+		if !options.Full {
+			// The per-node summary arrays carry what operators need;
+			// the full node objects dominate the output size
+			compact := *validationCluster
+			compact.NodeList = nil
+			result = &compact
+		}
+
+		if options.Output == OutputJSON {
+			// Encode streams to out rather than buffering the whole document
+			encoder := json.NewEncoder(out)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(result); err != nil {
+				return fmt.Errorf("error marshaling validation result: %v", err)
+			}
+		} else {
+			b, err := yaml.Marshal(result)
+			if err != nil {
+				return fmt.Errorf("error marshaling validation result: %v", err)
+			}
+			if _, err := out.Write(b); err != nil {
+				return fmt.Errorf("error writing to stdout: %v", err)
+			}
+		}
+
+		// The structured result carries the failure details; the error only sets the exit code
+		if validationFailed != nil {
+			return withExitCode(ExitCodeValidationFailed, validationFailed)
+		}
+		return nil
 	}
 
 	t := &tables.Table{}
@@ -194,7 +549,73 @@ func RunValidateCluster(f *util.Factory, cmd *cobra.Command, args []string, out
 		}
 	}
 
+	if len(validationCluster.CertificateFailures) != 0 {
+		certFailuresTable := &tables.Table{}
+		certFailuresTable.AddColumn("NAME", func(s string) string {
+			return s
+		})
+
+		fmt.Fprintln(out, "\nCertificates expiring soon")
+		err = certFailuresTable.Render(validationCluster.CertificateFailures, out, "NAME")
+
+		if err != nil {
+			return fmt.Errorf("cannot render certificates for %q: %v", cluster.ObjectMeta.Name, err)
+		}
+	}
+
+	if len(validationCluster.LoadBalancerFailures) != 0 {
+		lbFailuresTable := &tables.Table{}
+		lbFailuresTable.AddColumn("NAME", func(s string) string {
+			return s
+		})
+
+		fmt.Fprintln(out, "\nUnhealthy API load balancer targets")
+		err = lbFailuresTable.Render(validationCluster.LoadBalancerFailures, out, "NAME")
+
+		if err != nil {
+			return fmt.Errorf("cannot render load balancer targets for %q: %v", cluster.ObjectMeta.Name, err)
+		}
+	}
+
+	if len(validationCluster.APIServerHealthFailures) != 0 {
+		healthFailuresTable := &tables.Table{}
+		healthFailuresTable.AddColumn("NAME", func(s string) string {
+			return s
+		})
+
+		fmt.Fprintln(out, "\nFailing kube-apiserver health checks")
+		err = healthFailuresTable.Render(validationCluster.APIServerHealthFailures, out, "NAME")
+
+		if err != nil {
+			return fmt.Errorf("cannot render health checks for %q: %v", cluster.ObjectMeta.Name, err)
+		}
+	}
+
+	// These are warnings rather than failures, but kops toolbox gc can remove them
+	if len(orphanResources) != 0 {
+		orphanTable := &tables.Table{}
+		orphanTable.AddColumn("TYPE", func(o *resources.OrphanResource) string {
+			return o.Type
+		})
+		orphanTable.AddColumn("ID", func(o *resources.OrphanResource) string {
+			return o.ID
+		})
+		orphanTable.AddColumn("REASON", func(o *resources.OrphanResource) string {
+			return o.Reason
+		})
+
+		fmt.Fprintln(out, "\nWarning: resources not managed by any instance group (see kops toolbox gc)")
+		err = orphanTable.Render(orphanResources, out, "TYPE", "ID", "REASON")
+
+		if err != nil {
+			return fmt.Errorf("cannot render orphaned resources for %q: %v", cluster.ObjectMeta.Name, err)
+		}
+	}
+
 	if validationFailed == nil {
+		if len(validationCluster.NodesNotReadyArray) != 0 || validationCluster.NodesCount > len(validationCluster.NodesReadyArray) {
+			fmt.Fprintf(out, "\nReady Node(s) %d out of %d; within the tolerated failure budget.\n", len(validationCluster.NodesReadyArray), validationCluster.NodesCount)
+		}
 		fmt.Fprintf(out, "\nYour cluster %s is ready\n", cluster.ObjectMeta.Name)
 		return nil
 	} else {
@@ -203,6 +624,291 @@ func RunValidateCluster(f *util.Factory, cmd *cobra.Command, args []string, out
 		fmt.Fprint(out, "\nValidation Failed\n")
 		fmt.Fprintf(out, "Ready Master(s) %d out of %d.\n", len(validationCluster.MastersReadyArray), validationCluster.MastersCount)
 		fmt.Fprintf(out, "Ready Node(s) %d out of %d.\n", len(validationCluster.NodesReadyArray), validationCluster.NodesCount)
-		return validationFailed
+
+		if options.Diagnose {
+			cloud, err := cloudup.BuildCloud(cluster)
+			if err != nil {
+				return err
+			}
+
+			diagnoses, err := validation.DiagnoseUnjoinedNodes(cloud, validationCluster)
+			if err != nil {
+				glog.Warningf("cannot diagnose unjoined machines: %v", err)
+			} else if len(diagnoses) != 0 {
+				fmt.Fprint(out, "\nMachines that have not joined the cluster:\n")
+				for _, diagnosis := range diagnoses {
+					fmt.Fprintf(out, "  %s\n", diagnosis)
+				}
+			}
+		}
+
+		return withExitCode(ExitCodeValidationFailed, validationFailed)
+	}
+}
+
+// runValidationDiff compares the current validation result with a previously
+// saved one and reports only what changed.  New failures fail the command;
+// failures already present in the baseline do not.
+func runValidationDiff(out io.Writer, baselineFile string, v *validation.ValidationCluster) error {
+	data, err := ioutil.ReadFile(baselineFile)
+	if err != nil {
+		return fmt.Errorf("error reading baseline %q: %v", baselineFile, err)
+	}
+
+	baseline := &validation.ValidationCluster{}
+	// The saved result may be json or yaml; this parses both
+	if err := yaml.Unmarshal(data, baseline); err != nil {
+		return fmt.Errorf("error parsing baseline %q: %v", baselineFile, err)
+	}
+
+	base := failureSet(baseline)
+	current := failureSet(v)
+
+	var added, removed []string
+	for f := range current {
+		if !base[f] {
+			added = append(added, f)
+		}
+	}
+	for f := range base {
+		if !current[f] {
+			removed = append(removed, f)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	if len(added) == 0 && len(removed) == 0 {
+		fmt.Fprintf(out, "No changes in validation failures since %s\n", baseline.Timestamp.UTC().Format(time.RFC3339))
+		return nil
+	}
+
+	if len(added) != 0 {
+		fmt.Fprintf(out, "New failures since %s:\n", baseline.Timestamp.UTC().Format(time.RFC3339))
+		for _, f := range added {
+			fmt.Fprintf(out, "  + %s\n", f)
+		}
+	}
+	if len(removed) != 0 {
+		fmt.Fprintf(out, "Resolved failures:\n")
+		for _, f := range removed {
+			fmt.Fprintf(out, "  - %s\n", f)
+		}
+	}
+
+	if len(added) != 0 {
+		return withExitCode(ExitCodeValidationFailed, fmt.Errorf("cluster has %d new validation failure(s)", len(added)))
+	}
+	return nil
+}
+
+// filterInstanceGroups resolves the --instance-group and --instance-group-role
+// flags to the names of the instance groups to validate.  Unknown group names
+// are an error; a typo silently validating the whole cluster would be worse.
+func filterInstanceGroups(list *api.InstanceGroupList, names []string, roles []string) ([]string, error) {
+	byName := make(map[string]*api.InstanceGroup)
+	for i := range list.Items {
+		ig := &list.Items[i]
+		byName[ig.ObjectMeta.Name] = ig
+	}
+
+	scope := make(map[string]bool)
+	for _, name := range names {
+		if byName[name] == nil {
+			return nil, fmt.Errorf("instance group %q not found", name)
+		}
+		scope[name] = true
+	}
+
+	for _, role := range roles {
+		matched := false
+		for i := range list.Items {
+			ig := &list.Items[i]
+			if strings.EqualFold(string(ig.Spec.Role), role) {
+				scope[ig.ObjectMeta.Name] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("no instance groups with role %q found", role)
+		}
+	}
+
+	var scoped []string
+	for name := range scope {
+		scoped = append(scoped, name)
+	}
+	sort.Strings(scoped)
+	return scoped, nil
+}
+
+// parseTolerance parses the --tolerance flag value: a plain number is an absolute
+// count of not-ready nodes, a number with a "%" suffix is a percentage of the
+// expected nodes.  An empty value means no failure budget.
+func parseTolerance(s string) (*validation.Tolerance, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	tolerance := &validation.Tolerance{}
+	if strings.HasSuffix(s, "%") {
+		percent, err := strconv.Atoi(strings.TrimSuffix(s, "%"))
+		if err != nil || percent < 0 || percent > 100 {
+			return nil, fmt.Errorf("invalid tolerance %q: expected a percentage between 0%% and 100%%", s)
+		}
+		tolerance.MaxUnreadyPercent = percent
+	} else {
+		count, err := strconv.Atoi(s)
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("invalid tolerance %q: expected a non-negative number of nodes or a percentage", s)
+		}
+		tolerance.MaxUnreadyNodes = count
+	}
+	return tolerance, nil
+}
+
+// writeValidationMetrics renders the validation results in the prometheus textfile
+// collector format, so a cron-driven validation can feed node_exporter
+func writeValidationMetrics(out io.Writer, clusterName string, v *validation.ValidationCluster, validationFailed error, duration time.Duration) error {
+	b := &bytes.Buffer{}
+
+	label := fmt.Sprintf("{cluster=%q}", clusterName)
+
+	valid := 1
+	if validationFailed != nil {
+		valid = 0
+	}
+	fmt.Fprintf(b, "# HELP kops_validation_cluster_valid Whether the last kops validation of the cluster passed.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_cluster_valid gauge\n")
+	fmt.Fprintf(b, "kops_validation_cluster_valid%s %d\n", label, valid)
+
+	fmt.Fprintf(b, "# HELP kops_validation_duration_seconds How long the last validation took.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_duration_seconds gauge\n")
+	fmt.Fprintf(b, "kops_validation_duration_seconds%s %g\n", label, duration.Seconds())
+
+	if v == nil {
+		// Validation did not get far enough to produce a result
+		_, err := out.Write(b.Bytes())
+		return err
+	}
+
+	fmt.Fprintf(b, "# HELP kops_validation_masters_ready Number of ready master nodes.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_masters_ready gauge\n")
+	fmt.Fprintf(b, "kops_validation_masters_ready%s %d\n", label, len(v.MastersReadyArray))
+
+	fmt.Fprintf(b, "# HELP kops_validation_masters_expected Number of master nodes expected from the instance groups.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_masters_expected gauge\n")
+	fmt.Fprintf(b, "kops_validation_masters_expected%s %d\n", label, v.MastersCount)
+
+	fmt.Fprintf(b, "# HELP kops_validation_nodes_ready Number of ready worker nodes.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_nodes_ready gauge\n")
+	fmt.Fprintf(b, "kops_validation_nodes_ready%s %d\n", label, len(v.NodesReadyArray))
+
+	fmt.Fprintf(b, "# HELP kops_validation_nodes_expected Number of worker nodes expected from the instance groups.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_nodes_expected gauge\n")
+	fmt.Fprintf(b, "kops_validation_nodes_expected%s %d\n", label, v.NodesCount)
+
+	fmt.Fprintf(b, "# HELP kops_validation_component_failures Number of components reporting unhealthy.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_component_failures gauge\n")
+	fmt.Fprintf(b, "kops_validation_component_failures%s %d\n", label, len(v.ComponentFailures))
+
+	fmt.Fprintf(b, "# HELP kops_validation_pod_failures Number of kube-system pods reporting unhealthy.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_pod_failures gauge\n")
+	fmt.Fprintf(b, "kops_validation_pod_failures%s %d\n", label, len(v.PodFailures))
+
+	failuresByCode := make(map[string]int)
+	for _, failure := range v.Failures {
+		failuresByCode[failure.Code]++
+	}
+	var codes []string
+	for code := range failuresByCode {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	fmt.Fprintf(b, "# HELP kops_validation_failures Number of validation failures, by failure code.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_failures gauge\n")
+	for _, code := range codes {
+		fmt.Fprintf(b, "kops_validation_failures{cluster=%q,code=%q} %d\n", clusterName, code, failuresByCode[code])
+	}
+
+	_, err := out.Write(b.Bytes())
+	return err
+}
+
+// serveValidationMetrics revalidates the cluster on an interval and serves
+// the latest results on /metrics, so operators can alert on cluster health
+// from kops's perspective without wrapping the command in cron
+func serveValidationMetrics(listen string, interval time.Duration, clusterName string, list *api.InstanceGroupList, k8sClient kubernetes.Interface, tolerance *validation.Tolerance) error {
+	var mutex sync.Mutex
+	var latest []byte
+
+	refresh := func() {
+		start := time.Now()
+		v, validationFailed := validation.ValidateClusterWithTolerance(clusterName, list, k8sClient, tolerance)
+		if validationFailed != nil {
+			glog.V(2).Infof("validation of cluster %s failed: %v", clusterName, validationFailed)
+		}
+
+		b := &bytes.Buffer{}
+		if err := writeValidationMetrics(b, clusterName, v, validationFailed, time.Since(start)); err != nil {
+			glog.Warningf("cannot render validation metrics: %v", err)
+			return
+		}
+		writeInstanceGroupMetrics(b, clusterName, v, list)
+
+		mutex.Lock()
+		latest = b.Bytes()
+		mutex.Unlock()
+	}
+
+	refresh()
+	go func() {
+		for range time.Tick(interval) {
+			refresh()
+		}
+	}()
+
+	http.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		w.Write(latest)
+	})
+
+	glog.Infof("serving validation metrics for cluster %s on %s/metrics", clusterName, listen)
+	return http.ListenAndServe(listen, nil)
+}
+
+// writeInstanceGroupMetrics emits the ready node count per instance group, so
+// an alert can name the degraded group.  Nodes that cannot be attributed to an
+// instance group are counted under "unknown".
+func writeInstanceGroupMetrics(b *bytes.Buffer, clusterName string, v *validation.ValidationCluster, list *api.InstanceGroupList) {
+	if v == nil || v.NodeList == nil {
+		return
+	}
+
+	readyByGroup := make(map[string]int)
+	for i := range v.NodeList.Items {
+		node := &v.NodeList.Items[i]
+		if !validation.IsNodeOrMasterReady(node) {
+			continue
+		}
+		group := validation.InstanceGroupForNode(node, list)
+		if group == "" {
+			group = "unknown"
+		}
+		readyByGroup[group]++
+	}
+
+	var groups []string
+	for group := range readyByGroup {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	fmt.Fprintf(b, "# HELP kops_validation_ig_nodes_ready Number of ready nodes per instance group.\n")
+	fmt.Fprintf(b, "# TYPE kops_validation_ig_nodes_ready gauge\n")
+	for _, group := range groups {
+		fmt.Fprintf(b, "kops_validation_ig_nodes_ready{cluster=%q,instancegroup=%q} %d\n", clusterName, group, readyByGroup[group])
 	}
 }
@@ -0,0 +1,216 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/validation"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+var (
+	validate_serve_long = templates.LongDesc(i18n.T(`
+	Run the cluster validator as a long-running HTTP service, so other
+	systems (CD pipelines, autoscaler hooks) can request on-demand
+	validation without shelling out to the CLI.
+
+	GET /validate runs a validation and returns the result as JSON; the
+	response status is 200 when the cluster validates and 503 when it does
+	not, so callers can gate on the status code alone.  GET /healthz
+	reports the server itself.
+
+	When --auth-token-file is set, requests must carry the token in an
+	"Authorization: Bearer" header; run the server behind TLS termination,
+	since the token travels in the clear otherwise.`))
+
+	validate_serve_example = templates.Examples(i18n.T(`
+	# Serve on-demand validation on port 8088
+	kops validate serve --name k8s-cluster.example.com --listen :8088 --auth-token-file /etc/kops/validator-token
+	`))
+
+	validate_serve_short = i18n.T(`Serve cluster validation over an HTTP API`)
+)
+
+type ValidateServeOptions struct {
+	// Listen is the address the HTTP server binds to
+	Listen string
+
+	// AuthTokenFile is a file containing the bearer token required on
+	// requests; empty disables authentication
+	AuthTokenFile string
+
+	// Tolerance is the worker node failure budget, as in validate cluster
+	Tolerance string
+}
+
+func (o *ValidateServeOptions) InitDefaults() {
+	o.Listen = ":8088"
+}
+
+func NewCmdValidateServe(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ValidateServeOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:     "serve",
+		Short:   validate_serve_short,
+		Long:    validate_serve_long,
+		Example: validate_serve_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := rootCommand.ProcessArgs(args)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = RunValidateServe(f, out, options)
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Listen, "listen", options.Listen, "Address to serve the validation API on")
+	cmd.Flags().StringVar(&options.AuthTokenFile, "auth-token-file", options.AuthTokenFile, "File containing the bearer token required on requests; empty disables authentication")
+	cmd.Flags().StringVar(&options.Tolerance, "tolerance", options.Tolerance, "Number (\"2\") or percentage (\"10%\") of not-ready nodes to tolerate as an acceptable failure budget")
+
+	return cmd
+}
+
+func RunValidateServe(f *util.Factory, out io.Writer, options *ValidateServeOptions) error {
+	cluster, err := rootCommand.Cluster()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := f.Clientset()
+	if err != nil {
+		return err
+	}
+
+	igClient := clientSet.InstanceGroupsFor(cluster)
+
+	// Fail fast on misconfiguration, but re-list per request below, so that a
+	// long-running server picks up instance group edits
+	if _, err := igClient.List(metav1.ListOptions{}); err != nil {
+		return fmt.Errorf("cannot get InstanceGroups for %q: %v", cluster.ObjectMeta.Name, err)
+	}
+
+	var authToken string
+	if options.AuthTokenFile != "" {
+		b, err := ioutil.ReadFile(options.AuthTokenFile)
+		if err != nil {
+			return fmt.Errorf("cannot read auth token file %q: %v", options.AuthTokenFile, err)
+		}
+		authToken = strings.TrimSpace(string(b))
+		if authToken == "" {
+			return fmt.Errorf("auth token file %q is empty", options.AuthTokenFile)
+		}
+	}
+
+	tolerance, err := parseTolerance(options.Tolerance)
+	if err != nil {
+		return err
+	}
+
+	contextName := cluster.ObjectMeta.Name
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{CurrentContext: contextName}).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("Cannot load kubecfg settings for %q: %v\n", contextName, err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("Cannot build kube api client for %q: %v\n", contextName, err)
+	}
+
+	// Validations run one at a time; concurrent requests share the result
+	// of the run they are queued behind rather than stampeding the apiserver
+	var mutex sync.Mutex
+
+	authorized := func(r *http.Request) bool {
+		if authToken == "" {
+			return true
+		}
+		header := r.Header.Get("Authorization")
+		expected := "Bearer " + authToken
+		return subtle.ConstantTimeCompare([]byte(header), []byte(expected)) == 1
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("/validate", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		mutex.Lock()
+		list, err := igClient.List(metav1.ListOptions{})
+		if err != nil {
+			mutex.Unlock()
+			http.Error(w, fmt.Sprintf("validation could not run: cannot get InstanceGroups: %v", err), http.StatusServiceUnavailable)
+			return
+		}
+		v, validationFailed := validation.ValidateClusterWithTolerance(cluster.ObjectMeta.Name, list, k8sClient, tolerance)
+		mutex.Unlock()
+
+		status := http.StatusOK
+		if validationFailed != nil {
+			status = http.StatusServiceUnavailable
+		}
+
+		if v == nil {
+			http.Error(w, fmt.Sprintf("validation could not run: %v", validationFailed), status)
+			return
+		}
+
+		// The per-node summary arrays carry what callers need; the full
+		// node objects dominate the response size
+		compact := *v
+		compact.NodeList = nil
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(&compact); err != nil {
+			glog.Warningf("error writing validation response: %v", err)
+		}
+	})
+
+	glog.Infof("serving validation API for cluster %s on %s/validate", cluster.ObjectMeta.Name, options.Listen)
+	return http.ListenAndServe(options.Listen, mux)
+}
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 
@@ -32,9 +33,15 @@ import (
 	gossipdns "k8s.io/kops/protokube/pkg/gossip/dns"
 	gossipdnsprovider "k8s.io/kops/protokube/pkg/gossip/dns/provider"
 	"k8s.io/kubernetes/federation/pkg/dnsprovider"
+	"k8s.io/kubernetes/pkg/api"
+	kubeclientset "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/pkg/client/leaderelection"
+	"k8s.io/kubernetes/pkg/client/leaderelection/resourcelock"
 
 	"k8s.io/client-go/kubernetes"
+	clientv1 "k8s.io/client-go/pkg/api/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kops/protokube/pkg/gossip/mesh"
 	_ "k8s.io/kubernetes/federation/pkg/dnsprovider/providers/aws/route53"
 	k8scoredns "k8s.io/kubernetes/federation/pkg/dnsprovider/providers/coredns"
@@ -72,6 +79,15 @@ func main() {
 	watchIngress := true
 	flags.BoolVar(&watchIngress, "watch-ingress", watchIngress, "Configure hostnames found in ingress resources")
 
+	leaderElect := false
+	flags.BoolVar(&leaderElect, "leader-elect", leaderElect, "Use leader election so that dns-controller can run with multiple replicas, with one active at a time")
+
+	approveKubeletCSRs := false
+	flags.BoolVar(&approveKubeletCSRs, "approve-kubelet-csrs", approveKubeletCSRs, "Approve kubelet serving certificate requests whose names match the node's registered addresses")
+
+	healthzListen := ""
+	flags.StringVar(&healthzListen, "healthz-listen", healthzListen, "The address on which to serve /healthz (empty to disable)")
+
 	dnsServer := ""
 	flag.StringVar(&dnsServer, "dns-server", dnsServer, "DNS Server")
 
@@ -201,13 +217,89 @@ func main() {
 		glog.Infof("Ingress controller disabled")
 	}
 
-	go nodeController.Run()
-	go podController.Run()
-	go serviceController.Run()
+	var csrApprover *watchers.CSRApprover
+	if approveKubeletCSRs {
+		csrApprover, err = watchers.NewCSRApprover(kubeClient)
+		if err != nil {
+			glog.Errorf("Error building CSR approver: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	if healthzListen != "" {
+		go serveHealthz(healthzListen)
+	}
+
+	runControllers := func(stop <-chan struct{}) {
+		go nodeController.Run()
+		go podController.Run()
+		go serviceController.Run()
 
-	if ingressController != nil {
-		go ingressController.Run()
+		if ingressController != nil {
+			go ingressController.Run()
+		}
+		if csrApprover != nil {
+			go csrApprover.Run()
+		}
+
+		dnsController.Run()
 	}
 
-	dnsController.Run()
+	if !leaderElect {
+		runControllers(nil)
+		return
+	}
+
+	// With leader election, dns-controller can run a replica on every master;
+	// when the active replica's node is replaced during a rolling update a
+	// standby takes over instead of DNS updates stalling until the pod is
+	// rescheduled
+	id := os.Getenv("HOSTNAME")
+	if id == "" {
+		glog.Fatalf("Unable to fetch HOSTNAME for use as leader election identity")
+	}
+
+	// The resource lock wants the kubernetes clientset, not the client-go one
+	leaderElectionClient, err := kubeclientset.NewForConfig(config)
+	if err != nil {
+		glog.Fatalf("error building leader election client: %v", err)
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	recorder := eventBroadcaster.NewRecorder(api.Scheme, clientv1.EventSource{Component: "dns-controller"})
+
+	lock, err := resourcelock.New(resourcelock.EndpointsResourceLock, "kube-system", "dns-controller", leaderElectionClient, resourcelock.ResourceLockConfig{
+		Identity:      id,
+		EventRecorder: recorder,
+	})
+	if err != nil {
+		glog.Fatalf("error building leader election lock: %v", err)
+	}
+
+	leaderelection.RunOrDie(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: leaderelection.DefaultLeaseDuration,
+		RenewDeadline: leaderelection.DefaultRenewDeadline,
+		RetryPeriod:   leaderelection.DefaultRetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: runControllers,
+			OnStoppedLeading: func() {
+				// Exit so a standby replica can take over cleanly
+				glog.Fatalf("lost leader election lease")
+			},
+		},
+	})
+}
+
+// serveHealthz exposes a liveness/readiness endpoint so probes (and cluster
+// validation) can tell a wedged dns-controller from a healthy standby.
+func serveHealthz(listen string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	if err := http.ListenAndServe(listen, mux); err != nil {
+		glog.Fatalf("error serving healthz on %s: %v", listen, err)
+	}
 }
@@ -0,0 +1,222 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watchers
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	certificatesv1beta1 "k8s.io/client-go/pkg/apis/certificates/v1beta1"
+	"k8s.io/kops/dns-controller/pkg/util"
+)
+
+// nodeUserPrefix is the username prefix the kubelet authenticates with
+const nodeUserPrefix = "system:node:"
+
+// CSRApprover watches kubelet serving certificate signing requests and
+// approves the ones whose identity checks out, so serverTLSBootstrap works
+// without a third-party approver.  A CSR is only approved when it was
+// submitted by the node itself and every requested name matches an address
+// the node registered with the apiserver; anything else is left for an
+// administrator to judge.
+type CSRApprover struct {
+	util.Stoppable
+	kubeClient kubernetes.Interface
+}
+
+// NewCSRApprover creates a CSRApprover
+func NewCSRApprover(kubeClient kubernetes.Interface) (*CSRApprover, error) {
+	c := &CSRApprover{
+		kubeClient: kubeClient,
+	}
+
+	return c, nil
+}
+
+// Run starts the CSRApprover.
+func (c *CSRApprover) Run() {
+	glog.Infof("starting CSR approver")
+
+	stopCh := c.StopChannel()
+	go c.runWatcher(stopCh)
+
+	<-stopCh
+	glog.Infof("shutting down CSR approver")
+}
+
+func (c *CSRApprover) runWatcher(stopCh <-chan struct{}) {
+	runOnce := func() (bool, error) {
+		var listOpts metav1.ListOptions
+
+		csrList, err := c.kubeClient.CertificatesV1beta1().CertificateSigningRequests().List(listOpts)
+		if err != nil {
+			return false, fmt.Errorf("error listing certificate signing requests: %v", err)
+		}
+		for i := range csrList.Items {
+			c.maybeApprove(&csrList.Items[i])
+		}
+
+		listOpts.Watch = true
+		listOpts.ResourceVersion = csrList.ResourceVersion
+		watcher, err := c.kubeClient.CertificatesV1beta1().CertificateSigningRequests().Watch(listOpts)
+		if err != nil {
+			return false, fmt.Errorf("error watching certificate signing requests: %v", err)
+		}
+		ch := watcher.ResultChan()
+		for {
+			select {
+			case <-stopCh:
+				glog.Infof("Got stop signal")
+				return true, nil
+			case event, ok := <-ch:
+				if !ok {
+					glog.Infof("CSR watch channel closed")
+					return false, nil
+				}
+
+				csr := event.Object.(*certificatesv1beta1.CertificateSigningRequest)
+				glog.V(4).Infof("CSR changed: %s %v", event.Type, csr.Name)
+
+				switch event.Type {
+				case watch.Added, watch.Modified:
+					c.maybeApprove(csr)
+				}
+			}
+		}
+	}
+
+	for {
+		stop, err := runOnce()
+		if stop {
+			return
+		}
+
+		if err != nil {
+			glog.Warningf("Unexpected error in event watch, will retry: %v", err)
+			time.Sleep(10 * time.Second)
+		}
+	}
+}
+
+// maybeApprove approves the CSR if it is a verifiable kubelet serving
+// certificate request, and otherwise leaves it alone.
+func (c *CSRApprover) maybeApprove(csr *certificatesv1beta1.CertificateSigningRequest) {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == certificatesv1beta1.CertificateApproved || condition.Type == certificatesv1beta1.CertificateDenied {
+			return
+		}
+	}
+
+	if err := c.verifyKubeletServingCSR(csr); err != nil {
+		glog.V(2).Infof("not approving CSR %q: %v", csr.Name, err)
+		return
+	}
+
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1beta1.CertificateSigningRequestCondition{
+		Type:    certificatesv1beta1.CertificateApproved,
+		Reason:  "AutoApproved",
+		Message: "kubelet serving certificate request matches the node's registered identity",
+	})
+	if _, err := c.kubeClient.CertificatesV1beta1().CertificateSigningRequests().UpdateApproval(csr); err != nil {
+		glog.Warningf("error approving CSR %q: %v", csr.Name, err)
+		return
+	}
+	glog.Infof("approved kubelet serving certificate request %q for node %q", csr.Name, strings.TrimPrefix(csr.Spec.Username, nodeUserPrefix))
+}
+
+// verifyKubeletServingCSR checks that the CSR was submitted by a node for its
+// own serving certificate, and that every requested name is an address the
+// node registered with the apiserver.
+func (c *CSRApprover) verifyKubeletServingCSR(csr *certificatesv1beta1.CertificateSigningRequest) error {
+	if !strings.HasPrefix(csr.Spec.Username, nodeUserPrefix) {
+		return fmt.Errorf("not submitted by a node (user %q)", csr.Spec.Username)
+	}
+	nodeName := strings.TrimPrefix(csr.Spec.Username, nodeUserPrefix)
+
+	// The usage set must be exactly what a serving certificate needs; anything
+	// extra (in particular client auth) would let the certificate be used to
+	// impersonate the node as a client
+	requested := make(map[certificatesv1beta1.KeyUsage]bool)
+	for _, usage := range csr.Spec.Usages {
+		requested[usage] = true
+	}
+	if len(requested) != 3 || !requested[certificatesv1beta1.UsageKeyEncipherment] || !requested[certificatesv1beta1.UsageDigitalSignature] || !requested[certificatesv1beta1.UsageServerAuth] {
+		return fmt.Errorf("usages %v are not the kubelet serving set", csr.Spec.Usages)
+	}
+
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return fmt.Errorf("cannot decode certificate request")
+	}
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("cannot parse certificate request: %v", err)
+	}
+	if err := request.CheckSignature(); err != nil {
+		return fmt.Errorf("certificate request has an invalid signature: %v", err)
+	}
+
+	if request.Subject.CommonName != nodeUserPrefix+nodeName {
+		return fmt.Errorf("common name %q does not match the requesting node %q", request.Subject.CommonName, nodeName)
+	}
+	if len(request.Subject.Organization) != 1 || request.Subject.Organization[0] != "system:nodes" {
+		return fmt.Errorf("organization %v is not [system:nodes]", request.Subject.Organization)
+	}
+	if len(request.EmailAddresses) != 0 {
+		return fmt.Errorf("serving certificate request must not include email addresses")
+	}
+	if len(request.URIs) != 0 {
+		return fmt.Errorf("serving certificate request must not include URIs")
+	}
+
+	node, err := c.kubeClient.CoreV1().Nodes().Get(nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get node %q: %v", nodeName, err)
+	}
+
+	// Every requested name must be one the node registered; the node's
+	// addresses come from the cloud provider, so a compromised workload
+	// cannot get a certificate for someone else's name
+	registered := make(map[string]bool)
+	registered[nodeName] = true
+	for _, address := range node.Status.Addresses {
+		registered[address.Address] = true
+	}
+
+	for _, name := range request.DNSNames {
+		if !registered[name] {
+			return fmt.Errorf("requested DNS name %q is not an address of node %q", name, nodeName)
+		}
+	}
+	for _, ip := range request.IPAddresses {
+		if !registered[ip.String()] {
+			return fmt.Errorf("requested IP %q is not an address of node %q", ip.String(), nodeName)
+		}
+	}
+	if len(request.DNSNames) == 0 && len(request.IPAddresses) == 0 {
+		return fmt.Errorf("serving certificate request has no names")
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watchers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/pkg/api/v1"
+	certificatesv1beta1 "k8s.io/client-go/pkg/apis/certificates/v1beta1"
+)
+
+// csrTestCase describes a certificate signing request to build; the zero
+// fields of the valid base case are mutated per scenario
+type csrTestCase struct {
+	Name           string
+	Username       string
+	Usages         []certificatesv1beta1.KeyUsage
+	Subject        pkix.Name
+	DNSNames       []string
+	IPAddresses    []net.IP
+	EmailAddresses []string
+	URIs           []*url.URL
+	BreakSignature bool
+
+	// ExpectedError is a substring of the expected rejection; empty means the CSR must verify
+	ExpectedError string
+}
+
+func validServingCSRCase(name string) csrTestCase {
+	return csrTestCase{
+		Name:     name,
+		Username: "system:node:node1",
+		Usages: []certificatesv1beta1.KeyUsage{
+			certificatesv1beta1.UsageKeyEncipherment,
+			certificatesv1beta1.UsageDigitalSignature,
+			certificatesv1beta1.UsageServerAuth,
+		},
+		Subject: pkix.Name{
+			CommonName:   "system:node:node1",
+			Organization: []string{"system:nodes"},
+		},
+		DNSNames:    []string{"node1.internal"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+	}
+}
+
+func buildCSR(t *testing.T, key *ecdsa.PrivateKey, g csrTestCase) *certificatesv1beta1.CertificateSigningRequest {
+	template := &x509.CertificateRequest{
+		Subject:        g.Subject,
+		DNSNames:       g.DNSNames,
+		IPAddresses:    g.IPAddresses,
+		EmailAddresses: g.EmailAddresses,
+		URIs:           g.URIs,
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		t.Fatalf("error creating certificate request: %v", err)
+	}
+	if g.BreakSignature {
+		der[len(der)-1] ^= 0xff
+	}
+	return &certificatesv1beta1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "csr-" + g.Name},
+		Spec: certificatesv1beta1.CertificateSigningRequestSpec{
+			Username: g.Username,
+			Usages:   g.Usages,
+			Request:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+		},
+	}
+}
+
+func TestVerifyKubeletServingCSR(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: v1.NodeStatus{
+			Addresses: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: v1.NodeInternalDNS, Address: "node1.internal"},
+			},
+		},
+	}
+	approver, err := NewCSRApprover(fake.NewSimpleClientset(node))
+	if err != nil {
+		t.Fatalf("error building approver: %v", err)
+	}
+
+	var grid []csrTestCase
+
+	grid = append(grid, validServingCSRCase("valid"))
+
+	g := validServingCSRCase("not a node user")
+	g.Username = "system:serviceaccount:kube-system:default"
+	g.ExpectedError = "not submitted by a node"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("extra client auth usage")
+	g.Usages = append(g.Usages, certificatesv1beta1.UsageClientAuth)
+	g.ExpectedError = "kubelet serving set"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("missing server auth usage")
+	g.Usages = []certificatesv1beta1.KeyUsage{
+		certificatesv1beta1.UsageKeyEncipherment,
+		certificatesv1beta1.UsageDigitalSignature,
+	}
+	g.ExpectedError = "kubelet serving set"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("common name for another node")
+	g.Subject.CommonName = "system:node:node2"
+	g.ExpectedError = "common name"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("wrong organization")
+	g.Subject.Organization = []string{"system:masters"}
+	g.ExpectedError = "organization"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("unregistered dns name")
+	g.DNSNames = append(g.DNSNames, "api.example.com")
+	g.ExpectedError = "not an address"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("unregistered ip")
+	g.IPAddresses = append(g.IPAddresses, net.ParseIP("192.0.2.1"))
+	g.ExpectedError = "not an address"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("no names at all")
+	g.DNSNames = nil
+	g.IPAddresses = nil
+	g.ExpectedError = "no names"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("email addresses")
+	g.EmailAddresses = []string{"root@example.com"}
+	g.ExpectedError = "email addresses"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("uri sans")
+	g.URIs = []*url.URL{{Scheme: "spiffe", Host: "example.com", Path: "/node1"}}
+	g.ExpectedError = "URIs"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("tampered signature")
+	g.BreakSignature = true
+	g.ExpectedError = "signature"
+	grid = append(grid, g)
+
+	g = validServingCSRCase("unknown node")
+	g.Username = "system:node:node9"
+	g.Subject.CommonName = "system:node:node9"
+	g.ExpectedError = "cannot get node"
+	grid = append(grid, g)
+
+	for _, g := range grid {
+		err := approver.verifyKubeletServingCSR(buildCSR(t, key, g))
+		if g.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("%s: expected CSR to verify, got %v", g.Name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: expected rejection containing %q, but CSR verified", g.Name, g.ExpectedError)
+		} else if !strings.Contains(err.Error(), g.ExpectedError) {
+			t.Errorf("%s: expected rejection containing %q, got %v", g.Name, g.ExpectedError, err)
+		}
+	}
+}
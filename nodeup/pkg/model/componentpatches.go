@@ -0,0 +1,63 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/pkg/api/v1"
+)
+
+// patchPodManifest applies any ComponentPatches from the ClusterSpec that target the
+// named component to the generated pod, an escape hatch for settings kops does not
+// yet model.  With no matching patches, the pod is returned unchanged.
+func (c *NodeupModelContext) patchPodManifest(component string, pod *v1.Pod) (*v1.Pod, error) {
+	for i := range c.Cluster.Spec.ComponentPatches {
+		patchSpec := &c.Cluster.Spec.ComponentPatches[i]
+		if patchSpec.Component != component {
+			continue
+		}
+
+		glog.Infof("applying componentPatches[%d] to %s manifest", i, component)
+
+		original, err := json.Marshal(pod)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling %s pod for patching: %v", component, err)
+		}
+
+		patchJSON, err := utilyaml.ToJSON([]byte(patchSpec.Patch))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing patch for %s: %v", component, err)
+		}
+
+		patched, err := strategicpatch.StrategicMergePatch(original, patchJSON, v1.Pod{})
+		if err != nil {
+			return nil, fmt.Errorf("error applying patch to %s: %v", component, err)
+		}
+
+		pod = &v1.Pod{}
+		if err := json.Unmarshal(patched, pod); err != nil {
+			return nil, fmt.Errorf("error unmarshalling patched %s pod: %v", component, err)
+		}
+	}
+	return pod, nil
+}
@@ -57,6 +57,11 @@ func (b *KubeAPIServerBuilder) Build(c *fi.ModelBuilderContext) error {
 			return fmt.Errorf("error building kube-apiserver manifest: %v", err)
 		}
 
+		pod, err = b.patchPodManifest("kube-apiserver", pod)
+		if err != nil {
+			return err
+		}
+
 		manifest, err := ToVersionedYaml(pod)
 		if err != nil {
 			return fmt.Errorf("error marshalling manifest to yaml: %v", err)
@@ -281,9 +286,20 @@ func addHostPathMapping(pod *v1.Pod, container *v1.Container, name string, path
 
 func (b *KubeAPIServerBuilder) buildAnnotations() map[string]string {
 	annotations := make(map[string]string)
-	annotations["dns.alpha.kubernetes.io/internal"] = b.Cluster.Spec.MasterInternalName
-	if b.Cluster.Spec.API != nil && b.Cluster.Spec.API.DNS != nil {
-		annotations["dns.alpha.kubernetes.io/external"] = b.Cluster.Spec.MasterPublicName
+
+	internalNames := []string{b.Cluster.Spec.MasterInternalName}
+	if b.Cluster.Spec.API != nil {
+		if b.Cluster.Spec.API.DNS != nil {
+			annotations["dns.alpha.kubernetes.io/external"] = b.Cluster.Spec.MasterPublicName
+		}
+		// Split-horizon: dns-controller also publishes the public name in the
+		// cluster's zone, resolving to the masters' private IPs, so VPN
+		// clients reach the API without leaving the network
+		if fi.BoolValue(b.Cluster.Spec.API.SplitHorizon) && b.Cluster.Spec.MasterPublicName != "" {
+			internalNames = append(internalNames, b.Cluster.Spec.MasterPublicName)
+		}
 	}
+	annotations["dns.alpha.kubernetes.io/internal"] = strings.Join(internalNames, ",")
+
 	return annotations
 }
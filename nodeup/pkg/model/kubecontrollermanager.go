@@ -69,6 +69,11 @@ func (b *KubeControllerManagerBuilder) Build(c *fi.ModelBuilderContext) error {
 			return fmt.Errorf("error building kube-controller-manager pod: %v", err)
 		}
 
+		pod, err = b.patchPodManifest("kube-controller-manager", pod)
+		if err != nil {
+			return err
+		}
+
 		manifest, err := ToVersionedYaml(pod)
 		if err != nil {
 			return fmt.Errorf("error marshalling pod to yaml: %v", err)
@@ -51,6 +51,11 @@ func (b *KubeProxyBuilder) Build(c *fi.ModelBuilderContext) error {
 			return fmt.Errorf("error building kube-proxy manifest: %v", err)
 		}
 
+		pod, err = b.patchPodManifest("kube-proxy", pod)
+		if err != nil {
+			return err
+		}
+
 		manifest, err := ToVersionedYaml(pod)
 		if err != nil {
 			return fmt.Errorf("error marshalling manifest to yaml: %v", err)
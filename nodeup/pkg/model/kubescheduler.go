@@ -45,6 +45,11 @@ func (b *KubeSchedulerBuilder) Build(c *fi.ModelBuilderContext) error {
 			return fmt.Errorf("error building kube-scheduler pod: %v", err)
 		}
 
+		pod, err = b.patchPodManifest("kube-scheduler", pod)
+		if err != nil {
+			return err
+		}
+
 		manifest, err := ToVersionedYaml(pod)
 		if err != nil {
 			return fmt.Errorf("error marshalling pod to yaml: %v", err)
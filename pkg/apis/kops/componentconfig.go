@@ -422,6 +422,12 @@ type KubeAPIServerConfig struct {
 	KubeletClientCertificate string `json:"kubeletClientCertificate,omitempty" flag:"kubelet-client-certificate"`
 	KubeletClientKey         string `json:"kubeletClientKey,omitempty" flag:"kubelet-client-key"`
 
+	// SSHUser and SSHKeyfile configure the apiserver's built-in SSH tunnels
+	// for apiserver->node traffic (logs, exec, proxy), for topologies where
+	// the apiserver cannot open connections to the kubelets directly
+	SSHUser    string `json:"sshUser,omitempty" flag:"ssh-user"`
+	SSHKeyfile string `json:"sshKeyfile,omitempty" flag:"ssh-keyfile"`
+
 	// TODO: Remove unused TokenAuthFile
 	TokenAuthFile string `json:"tokenAuthFile,omitempty" flag:"token-auth-file"`
 
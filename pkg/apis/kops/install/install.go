@@ -26,6 +26,7 @@ import (
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/v1alpha1"
 	"k8s.io/kops/pkg/apis/kops/v1alpha2"
+	"k8s.io/kops/pkg/apis/kops/v1alpha3"
 )
 
 func init() {
@@ -41,6 +42,7 @@ func Install(groupFactoryRegistry announced.APIGroupFactoryRegistry, registry *r
 			GroupName: kops.GroupName,
 			VersionPreferenceOrder: []string{
 				v1alpha2.SchemeGroupVersion.Version,
+				v1alpha3.SchemeGroupVersion.Version,
 				v1alpha1.SchemeGroupVersion.Version,
 			},
 			RootScopedKinds:            sets.NewString("Cluster"),
@@ -50,6 +52,7 @@ func Install(groupFactoryRegistry announced.APIGroupFactoryRegistry, registry *r
 		announced.VersionToSchemeFunc{
 			v1alpha1.SchemeGroupVersion.Version: v1alpha1.AddToScheme,
 			v1alpha2.SchemeGroupVersion.Version: v1alpha2.AddToScheme,
+			v1alpha3.SchemeGroupVersion.Version: v1alpha3.AddToScheme,
 		},
 	).Announce(groupFactoryRegistry).RegisterAndEnable(registry, scheme)
 }
@@ -106,6 +106,54 @@ type InstanceGroupSpec struct {
 
 	// Taints indicates the kubernetes taints for nodes in this group
 	Taints []string `json:"taints,omitempty"`
+
+	// CloudLabelsPolicy overrides the cluster-level CloudLabelsPolicy for this group
+	CloudLabelsPolicy *CloudLabelsPolicySpec `json:"cloudLabelsPolicy,omitempty"`
+
+	// ScheduledActions are scheduled changes to the size of this group (e.g. a nightly scale-down)
+	ScheduledActions []ScheduledActionSpec `json:"scheduledActions,omitempty"`
+
+	// ExpiresAt, if set, marks this group as temporary; after this time the group
+	// is deleted by kops update cluster
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// PrePullImages are docker images to pull on the node at startup, before
+	// workloads land on it
+	PrePullImages []string `json:"prePullImages,omitempty"`
+	// MinHealthyPercent is the percentage of this group's expected nodes that must be
+	// Ready for validation to pass (default 100).  Lower values keep validation stable
+	// for large autoscaled groups that fluctuate by an instance or two.
+	MinHealthyPercent *int32 `json:"minHealthyPercent,omitempty"`
+	// MaxInstanceLifetime, if set, marks instances older than the limit for
+	// replacement on the next rolling update, keeping the fleet fresh without
+	// manual rolls
+	MaxInstanceLifetime *metav1.Duration `json:"maxInstanceLifetime,omitempty"`
+	// RollingUpdate configures how rolling updates replace the instances in
+	// this group
+	RollingUpdate *RollingUpdateSpec `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateSpec configures how a rolling update replaces the instances of
+// an InstanceGroup
+type RollingUpdateSpec struct {
+	// MaxSurge is the number of extra instances to create before old instances
+	// are terminated, so the group keeps its capacity during the update.
+	// Surging is only supported on clouds that can detach instances from their
+	// scaling group; elsewhere instances are replaced in place.
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+}
+
+// ScheduledActionSpec describes a scheduled change to the size of an InstanceGroup,
+// applied as an autoscaling group scheduled action by the cloud
+type ScheduledActionSpec struct {
+	// Schedule is a cron expression (in UTC) describing when the action runs
+	Schedule string `json:"schedule,omitempty"`
+	// MinSize is the minimum size to apply, if set
+	MinSize *int32 `json:"minSize,omitempty"`
+	// MaxSize is the maximum size to apply, if set
+	MaxSize *int32 `json:"maxSize,omitempty"`
+	// DesiredCapacity is the size to scale to at the scheduled time, if set
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
 }
 
 // PerformAssignmentsInstanceGroups populates InstanceGroups with default values
@@ -37,6 +37,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedConversionFuncs(
 		Convert_v1alpha1_AccessSpec_To_kops_AccessSpec,
 		Convert_kops_AccessSpec_To_v1alpha1_AccessSpec,
+		Convert_v1alpha1_AdoptSpec_To_kops_AdoptSpec,
+		Convert_kops_AdoptSpec_To_v1alpha1_AdoptSpec,
 		Convert_v1alpha1_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec,
 		Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha1_AlwaysAllowAuthorizationSpec,
 		Convert_v1alpha1_AuthenticationSpec_To_kops_AuthenticationSpec,
@@ -53,12 +55,16 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_kops_ClassicNetworkingSpec_To_v1alpha1_ClassicNetworkingSpec,
 		Convert_v1alpha1_CloudConfiguration_To_kops_CloudConfiguration,
 		Convert_kops_CloudConfiguration_To_v1alpha1_CloudConfiguration,
+		Convert_v1alpha1_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec,
+		Convert_kops_CloudLabelsPolicySpec_To_v1alpha1_CloudLabelsPolicySpec,
 		Convert_v1alpha1_Cluster_To_kops_Cluster,
 		Convert_kops_Cluster_To_v1alpha1_Cluster,
 		Convert_v1alpha1_ClusterList_To_kops_ClusterList,
 		Convert_kops_ClusterList_To_v1alpha1_ClusterList,
 		Convert_v1alpha1_ClusterSpec_To_kops_ClusterSpec,
 		Convert_kops_ClusterSpec_To_v1alpha1_ClusterSpec,
+		Convert_v1alpha1_ComponentPatchSpec_To_kops_ComponentPatchSpec,
+		Convert_kops_ComponentPatchSpec_To_v1alpha1_ComponentPatchSpec,
 		Convert_v1alpha1_DNSAccessSpec_To_kops_DNSAccessSpec,
 		Convert_kops_DNSAccessSpec_To_v1alpha1_DNSAccessSpec,
 		Convert_v1alpha1_DNSSpec_To_kops_DNSSpec,
@@ -113,10 +119,20 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_kops_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration,
 		Convert_v1alpha1_LoadBalancerAccessSpec_To_kops_LoadBalancerAccessSpec,
 		Convert_kops_LoadBalancerAccessSpec_To_v1alpha1_LoadBalancerAccessSpec,
+		Convert_v1alpha1_MetricGateSpec_To_kops_MetricGateSpec,
+		Convert_kops_MetricGateSpec_To_v1alpha1_MetricGateSpec,
 		Convert_v1alpha1_NetworkingSpec_To_kops_NetworkingSpec,
 		Convert_kops_NetworkingSpec_To_v1alpha1_NetworkingSpec,
 		Convert_v1alpha1_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec,
 		Convert_kops_RBACAuthorizationSpec_To_v1alpha1_RBACAuthorizationSpec,
+		Convert_v1alpha1_RollingUpdateSpec_To_kops_RollingUpdateSpec,
+		Convert_kops_RollingUpdateSpec_To_v1alpha1_RollingUpdateSpec,
+		Convert_v1alpha1_ScheduledActionSpec_To_kops_ScheduledActionSpec,
+		Convert_kops_ScheduledActionSpec_To_v1alpha1_ScheduledActionSpec,
+		Convert_v1alpha1_ValidationSpec_To_kops_ValidationSpec,
+		Convert_kops_ValidationSpec_To_v1alpha1_ValidationSpec,
+		Convert_v1alpha1_VerificationCheckSpec_To_kops_VerificationCheckSpec,
+		Convert_kops_VerificationCheckSpec_To_v1alpha1_VerificationCheckSpec,
 		Convert_v1alpha1_WeaveNetworkingSpec_To_kops_WeaveNetworkingSpec,
 		Convert_kops_WeaveNetworkingSpec_To_v1alpha1_WeaveNetworkingSpec,
 	)
@@ -141,6 +157,7 @@ func autoConvert_v1alpha1_AccessSpec_To_kops_AccessSpec(in *AccessSpec, out *kop
 	} else {
 		out.LoadBalancer = nil
 	}
+	out.SplitHorizon = in.SplitHorizon
 	return nil
 }
 
@@ -168,6 +185,7 @@ func autoConvert_kops_AccessSpec_To_v1alpha1_AccessSpec(in *kops.AccessSpec, out
 	} else {
 		out.LoadBalancer = nil
 	}
+	out.SplitHorizon = in.SplitHorizon
 	return nil
 }
 
@@ -176,6 +194,34 @@ func Convert_kops_AccessSpec_To_v1alpha1_AccessSpec(in *kops.AccessSpec, out *Ac
 	return autoConvert_kops_AccessSpec_To_v1alpha1_AccessSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_AdoptSpec_To_kops_AdoptSpec(in *AdoptSpec, out *kops.AdoptSpec, s conversion.Scope) error {
+	out.MastersSecurityGroup = in.MastersSecurityGroup
+	out.NodesSecurityGroup = in.NodesSecurityGroup
+	out.MastersInstanceProfile = in.MastersInstanceProfile
+	out.NodesInstanceProfile = in.NodesInstanceProfile
+	out.APILoadBalancer = in.APILoadBalancer
+	return nil
+}
+
+// Convert_v1alpha1_AdoptSpec_To_kops_AdoptSpec is an autogenerated conversion function.
+func Convert_v1alpha1_AdoptSpec_To_kops_AdoptSpec(in *AdoptSpec, out *kops.AdoptSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdoptSpec_To_kops_AdoptSpec(in, out, s)
+}
+
+func autoConvert_kops_AdoptSpec_To_v1alpha1_AdoptSpec(in *kops.AdoptSpec, out *AdoptSpec, s conversion.Scope) error {
+	out.MastersSecurityGroup = in.MastersSecurityGroup
+	out.NodesSecurityGroup = in.NodesSecurityGroup
+	out.MastersInstanceProfile = in.MastersInstanceProfile
+	out.NodesInstanceProfile = in.NodesInstanceProfile
+	out.APILoadBalancer = in.APILoadBalancer
+	return nil
+}
+
+// Convert_kops_AdoptSpec_To_v1alpha1_AdoptSpec is an autogenerated conversion function.
+func Convert_kops_AdoptSpec_To_v1alpha1_AdoptSpec(in *kops.AdoptSpec, out *AdoptSpec, s conversion.Scope) error {
+	return autoConvert_kops_AdoptSpec_To_v1alpha1_AdoptSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -398,6 +444,28 @@ func Convert_kops_CloudConfiguration_To_v1alpha1_CloudConfiguration(in *kops.Clo
 	return autoConvert_kops_CloudConfiguration_To_v1alpha1_CloudConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(in *CloudLabelsPolicySpec, out *kops.CloudLabelsPolicySpec, s conversion.Scope) error {
+	out.Volumes = in.Volumes
+	out.InstanceGroups = in.InstanceGroups
+	return nil
+}
+
+// Convert_v1alpha1_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(in *CloudLabelsPolicySpec, out *kops.CloudLabelsPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(in, out, s)
+}
+
+func autoConvert_kops_CloudLabelsPolicySpec_To_v1alpha1_CloudLabelsPolicySpec(in *kops.CloudLabelsPolicySpec, out *CloudLabelsPolicySpec, s conversion.Scope) error {
+	out.Volumes = in.Volumes
+	out.InstanceGroups = in.InstanceGroups
+	return nil
+}
+
+// Convert_kops_CloudLabelsPolicySpec_To_v1alpha1_CloudLabelsPolicySpec is an autogenerated conversion function.
+func Convert_kops_CloudLabelsPolicySpec_To_v1alpha1_CloudLabelsPolicySpec(in *kops.CloudLabelsPolicySpec, out *CloudLabelsPolicySpec, s conversion.Scope) error {
+	return autoConvert_kops_CloudLabelsPolicySpec_To_v1alpha1_CloudLabelsPolicySpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_Cluster_To_kops_Cluster(in *Cluster, out *kops.Cluster, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ClusterSpec_To_kops_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -628,6 +696,15 @@ func autoConvert_v1alpha1_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 		out.Authorization = nil
 	}
 	out.CloudLabels = in.CloudLabels
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(kops.CloudLabelsPolicySpec)
+		if err := Convert_v1alpha1_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = make([]kops.HookSpec, len(*in))
@@ -639,6 +716,47 @@ func autoConvert_v1alpha1_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.Hooks = nil
 	}
+	if in.ComponentPatches != nil {
+		in, out := &in.ComponentPatches, &out.ComponentPatches
+		*out = make([]kops.ComponentPatchSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ComponentPatchSpec_To_kops_ComponentPatchSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ComponentPatches = nil
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(kops.AdoptSpec)
+		if err := Convert_v1alpha1_AdoptSpec_To_kops_AdoptSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Adopt = nil
+	}
+	if in.RollingUpdateVerification != nil {
+		in, out := &in.RollingUpdateVerification, &out.RollingUpdateVerification
+		*out = make([]kops.VerificationCheckSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VerificationCheckSpec_To_kops_VerificationCheckSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RollingUpdateVerification = nil
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(kops.ValidationSpec)
+		if err := Convert_v1alpha1_ValidationSpec_To_kops_ValidationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Validation = nil
+	}
+	out.FeatureGates = in.FeatureGates
 	return nil
 }
 
@@ -804,6 +922,15 @@ func autoConvert_kops_ClusterSpec_To_v1alpha1_ClusterSpec(in *kops.ClusterSpec,
 		out.Authorization = nil
 	}
 	out.CloudLabels = in.CloudLabels
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(CloudLabelsPolicySpec)
+		if err := Convert_kops_CloudLabelsPolicySpec_To_v1alpha1_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = make([]HookSpec, len(*in))
@@ -815,6 +942,47 @@ func autoConvert_kops_ClusterSpec_To_v1alpha1_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.Hooks = nil
 	}
+	if in.ComponentPatches != nil {
+		in, out := &in.ComponentPatches, &out.ComponentPatches
+		*out = make([]ComponentPatchSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_ComponentPatchSpec_To_v1alpha1_ComponentPatchSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ComponentPatches = nil
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(AdoptSpec)
+		if err := Convert_kops_AdoptSpec_To_v1alpha1_AdoptSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Adopt = nil
+	}
+	if in.RollingUpdateVerification != nil {
+		in, out := &in.RollingUpdateVerification, &out.RollingUpdateVerification
+		*out = make([]VerificationCheckSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_VerificationCheckSpec_To_v1alpha1_VerificationCheckSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RollingUpdateVerification = nil
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(ValidationSpec)
+		if err := Convert_kops_ValidationSpec_To_v1alpha1_ValidationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Validation = nil
+	}
+	out.FeatureGates = in.FeatureGates
 	return nil
 }
 
@@ -944,6 +1112,28 @@ func Convert_kops_EtcdClusterSpec_To_v1alpha1_EtcdClusterSpec(in *kops.EtcdClust
 	return autoConvert_kops_EtcdClusterSpec_To_v1alpha1_EtcdClusterSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentPatchSpec_To_kops_ComponentPatchSpec(in *ComponentPatchSpec, out *kops.ComponentPatchSpec, s conversion.Scope) error {
+	out.Component = in.Component
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_v1alpha1_ComponentPatchSpec_To_kops_ComponentPatchSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentPatchSpec_To_kops_ComponentPatchSpec(in *ComponentPatchSpec, out *kops.ComponentPatchSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentPatchSpec_To_kops_ComponentPatchSpec(in, out, s)
+}
+
+func autoConvert_kops_ComponentPatchSpec_To_v1alpha1_ComponentPatchSpec(in *kops.ComponentPatchSpec, out *ComponentPatchSpec, s conversion.Scope) error {
+	out.Component = in.Component
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_kops_ComponentPatchSpec_To_v1alpha1_ComponentPatchSpec is an autogenerated conversion function.
+func Convert_kops_ComponentPatchSpec_To_v1alpha1_ComponentPatchSpec(in *kops.ComponentPatchSpec, out *ComponentPatchSpec, s conversion.Scope) error {
+	return autoConvert_kops_ComponentPatchSpec_To_v1alpha1_ComponentPatchSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_EtcdMemberSpec_To_kops_EtcdMemberSpec(in *EtcdMemberSpec, out *kops.EtcdMemberSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	// WARNING: in.Zone requires manual conversion: does not exist in peer-type
@@ -1244,6 +1434,39 @@ func autoConvert_v1alpha1_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(kops.CloudLabelsPolicySpec)
+		if err := Convert_v1alpha1_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
+	if in.ScheduledActions != nil {
+		in, out := &in.ScheduledActions, &out.ScheduledActions
+		*out = make([]kops.ScheduledActionSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ScheduledActionSpec_To_kops_ScheduledActionSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScheduledActions = nil
+	}
+	out.ExpiresAt = in.ExpiresAt
+	out.PrePullImages = in.PrePullImages
+	out.MinHealthyPercent = in.MinHealthyPercent
+	out.MaxInstanceLifetime = in.MaxInstanceLifetime
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(kops.RollingUpdateSpec)
+		if err := Convert_v1alpha1_RollingUpdateSpec_To_kops_RollingUpdateSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -1273,6 +1496,39 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha1_InstanceGroupSpec(in *kops.I
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(CloudLabelsPolicySpec)
+		if err := Convert_kops_CloudLabelsPolicySpec_To_v1alpha1_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
+	if in.ScheduledActions != nil {
+		in, out := &in.ScheduledActions, &out.ScheduledActions
+		*out = make([]ScheduledActionSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_ScheduledActionSpec_To_v1alpha1_ScheduledActionSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScheduledActions = nil
+	}
+	out.ExpiresAt = in.ExpiresAt
+	out.PrePullImages = in.PrePullImages
+	out.MinHealthyPercent = in.MinHealthyPercent
+	out.MaxInstanceLifetime = in.MaxInstanceLifetime
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateSpec)
+		if err := Convert_kops_RollingUpdateSpec_To_v1alpha1_RollingUpdateSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -1329,6 +1585,8 @@ func autoConvert_v1alpha1_KubeAPIServerConfig_To_kops_KubeAPIServerConfig(in *Ku
 	out.TLSPrivateKeyFile = in.TLSPrivateKeyFile
 	out.KubeletClientCertificate = in.KubeletClientCertificate
 	out.KubeletClientKey = in.KubeletClientKey
+	out.SSHUser = in.SSHUser
+	out.SSHKeyfile = in.SSHKeyfile
 	out.TokenAuthFile = in.TokenAuthFile
 	out.AllowPrivileged = in.AllowPrivileged
 	out.APIServerCount = in.APIServerCount
@@ -1376,6 +1634,8 @@ func autoConvert_kops_KubeAPIServerConfig_To_v1alpha1_KubeAPIServerConfig(in *ko
 	out.TLSPrivateKeyFile = in.TLSPrivateKeyFile
 	out.KubeletClientCertificate = in.KubeletClientCertificate
 	out.KubeletClientKey = in.KubeletClientKey
+	out.SSHUser = in.SSHUser
+	out.SSHKeyfile = in.SSHKeyfile
 	out.TokenAuthFile = in.TokenAuthFile
 	out.AllowPrivileged = in.AllowPrivileged
 	out.APIServerCount = in.APIServerCount
@@ -1744,6 +2004,34 @@ func Convert_kops_LoadBalancerAccessSpec_To_v1alpha1_LoadBalancerAccessSpec(in *
 	return autoConvert_kops_LoadBalancerAccessSpec_To_v1alpha1_LoadBalancerAccessSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_MetricGateSpec_To_kops_MetricGateSpec(in *MetricGateSpec, out *kops.MetricGateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.MaxValue = in.MaxValue
+	out.MinValue = in.MinValue
+	return nil
+}
+
+// Convert_v1alpha1_MetricGateSpec_To_kops_MetricGateSpec is an autogenerated conversion function.
+func Convert_v1alpha1_MetricGateSpec_To_kops_MetricGateSpec(in *MetricGateSpec, out *kops.MetricGateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricGateSpec_To_kops_MetricGateSpec(in, out, s)
+}
+
+func autoConvert_kops_MetricGateSpec_To_v1alpha1_MetricGateSpec(in *kops.MetricGateSpec, out *MetricGateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.MaxValue = in.MaxValue
+	out.MinValue = in.MinValue
+	return nil
+}
+
+// Convert_kops_MetricGateSpec_To_v1alpha1_MetricGateSpec is an autogenerated conversion function.
+func Convert_kops_MetricGateSpec_To_v1alpha1_MetricGateSpec(in *kops.MetricGateSpec, out *MetricGateSpec, s conversion.Scope) error {
+	return autoConvert_kops_MetricGateSpec_To_v1alpha1_MetricGateSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkingSpec_To_kops_NetworkingSpec(in *NetworkingSpec, out *kops.NetworkingSpec, s conversion.Scope) error {
 	if in.Classic != nil {
 		in, out := &in.Classic, &out.Classic
@@ -1960,6 +2248,126 @@ func Convert_kops_RBACAuthorizationSpec_To_v1alpha1_RBACAuthorizationSpec(in *ko
 	return autoConvert_kops_RBACAuthorizationSpec_To_v1alpha1_RBACAuthorizationSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_RollingUpdateSpec_To_kops_RollingUpdateSpec(in *RollingUpdateSpec, out *kops.RollingUpdateSpec, s conversion.Scope) error {
+	out.MaxSurge = in.MaxSurge
+	return nil
+}
+
+// Convert_v1alpha1_RollingUpdateSpec_To_kops_RollingUpdateSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RollingUpdateSpec_To_kops_RollingUpdateSpec(in *RollingUpdateSpec, out *kops.RollingUpdateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollingUpdateSpec_To_kops_RollingUpdateSpec(in, out, s)
+}
+
+func autoConvert_kops_RollingUpdateSpec_To_v1alpha1_RollingUpdateSpec(in *kops.RollingUpdateSpec, out *RollingUpdateSpec, s conversion.Scope) error {
+	out.MaxSurge = in.MaxSurge
+	return nil
+}
+
+// Convert_kops_RollingUpdateSpec_To_v1alpha1_RollingUpdateSpec is an autogenerated conversion function.
+func Convert_kops_RollingUpdateSpec_To_v1alpha1_RollingUpdateSpec(in *kops.RollingUpdateSpec, out *RollingUpdateSpec, s conversion.Scope) error {
+	return autoConvert_kops_RollingUpdateSpec_To_v1alpha1_RollingUpdateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScheduledActionSpec_To_kops_ScheduledActionSpec(in *ScheduledActionSpec, out *kops.ScheduledActionSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.MinSize = in.MinSize
+	out.MaxSize = in.MaxSize
+	out.DesiredCapacity = in.DesiredCapacity
+	return nil
+}
+
+// Convert_v1alpha1_ScheduledActionSpec_To_kops_ScheduledActionSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ScheduledActionSpec_To_kops_ScheduledActionSpec(in *ScheduledActionSpec, out *kops.ScheduledActionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScheduledActionSpec_To_kops_ScheduledActionSpec(in, out, s)
+}
+
+func autoConvert_kops_ScheduledActionSpec_To_v1alpha1_ScheduledActionSpec(in *kops.ScheduledActionSpec, out *ScheduledActionSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.MinSize = in.MinSize
+	out.MaxSize = in.MaxSize
+	out.DesiredCapacity = in.DesiredCapacity
+	return nil
+}
+
+// Convert_kops_ScheduledActionSpec_To_v1alpha1_ScheduledActionSpec is an autogenerated conversion function.
+func Convert_kops_ScheduledActionSpec_To_v1alpha1_ScheduledActionSpec(in *kops.ScheduledActionSpec, out *ScheduledActionSpec, s conversion.Scope) error {
+	return autoConvert_kops_ScheduledActionSpec_To_v1alpha1_ScheduledActionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ValidationSpec_To_kops_ValidationSpec(in *ValidationSpec, out *kops.ValidationSpec, s conversion.Scope) error {
+	if in.MetricGates != nil {
+		in, out := &in.MetricGates, &out.MetricGates
+		*out = make([]kops.MetricGateSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_MetricGateSpec_To_kops_MetricGateSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MetricGates = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ValidationSpec_To_kops_ValidationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ValidationSpec_To_kops_ValidationSpec(in *ValidationSpec, out *kops.ValidationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValidationSpec_To_kops_ValidationSpec(in, out, s)
+}
+
+func autoConvert_kops_ValidationSpec_To_v1alpha1_ValidationSpec(in *kops.ValidationSpec, out *ValidationSpec, s conversion.Scope) error {
+	if in.MetricGates != nil {
+		in, out := &in.MetricGates, &out.MetricGates
+		*out = make([]MetricGateSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_MetricGateSpec_To_v1alpha1_MetricGateSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MetricGates = nil
+	}
+	return nil
+}
+
+// Convert_kops_ValidationSpec_To_v1alpha1_ValidationSpec is an autogenerated conversion function.
+func Convert_kops_ValidationSpec_To_v1alpha1_ValidationSpec(in *kops.ValidationSpec, out *ValidationSpec, s conversion.Scope) error {
+	return autoConvert_kops_ValidationSpec_To_v1alpha1_ValidationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VerificationCheckSpec_To_kops_VerificationCheckSpec(in *VerificationCheckSpec, out *kops.VerificationCheckSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.URL = in.URL
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.Image = in.Image
+	out.Command = in.Command
+	out.TimeoutSeconds = in.TimeoutSeconds
+	return nil
+}
+
+// Convert_v1alpha1_VerificationCheckSpec_To_kops_VerificationCheckSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VerificationCheckSpec_To_kops_VerificationCheckSpec(in *VerificationCheckSpec, out *kops.VerificationCheckSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VerificationCheckSpec_To_kops_VerificationCheckSpec(in, out, s)
+}
+
+func autoConvert_kops_VerificationCheckSpec_To_v1alpha1_VerificationCheckSpec(in *kops.VerificationCheckSpec, out *VerificationCheckSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.URL = in.URL
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.Image = in.Image
+	out.Command = in.Command
+	out.TimeoutSeconds = in.TimeoutSeconds
+	return nil
+}
+
+// Convert_kops_VerificationCheckSpec_To_v1alpha1_VerificationCheckSpec is an autogenerated conversion function.
+func Convert_kops_VerificationCheckSpec_To_v1alpha1_VerificationCheckSpec(in *kops.VerificationCheckSpec, out *VerificationCheckSpec, s conversion.Scope) error {
+	return autoConvert_kops_VerificationCheckSpec_To_v1alpha1_VerificationCheckSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_WeaveNetworkingSpec_To_kops_WeaveNetworkingSpec(in *WeaveNetworkingSpec, out *kops.WeaveNetworkingSpec, s conversion.Scope) error {
 	out.MTU = in.MTU
 	return nil
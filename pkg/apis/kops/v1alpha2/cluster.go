@@ -167,14 +167,118 @@ type ClusterSpec struct {
 	// Tags for AWS resources
 	CloudLabels map[string]string `json:"cloudLabels,omitempty"`
 
+	// CloudLabelsPolicy controls which cloud resource types the CloudLabels are applied to
+	CloudLabelsPolicy *CloudLabelsPolicySpec `json:"cloudLabelsPolicy,omitempty"`
+
 	// Hooks for custom actions e.g. on first installation
 	Hooks []HookSpec `json:"hooks,omitempty"`
+	// ComponentPatches are strategic-merge patches applied to the generated
+	// static pod manifests at render time; an escape hatch for settings that
+	// kops does not yet model
+	ComponentPatches []ComponentPatchSpec `json:"componentPatches,omitempty"`
+
+	// Adopt references existing cloud resources that kops should use without
+	// managing their lifecycle
+	Adopt *AdoptSpec `json:"adopt,omitempty"`
+
+	// RollingUpdateVerification is a list of checks run after a rolling update
+	// completes; the update is not reported as successful until they all pass
+	RollingUpdateVerification []VerificationCheckSpec `json:"rollingUpdateVerification,omitempty"`
+
+	// Validation configures extra gates evaluated by cluster validation
+	Validation *ValidationSpec `json:"validation,omitempty"`
+
+	// FeatureGates lists kops feature flags to apply for this cluster
+	// ("Flag", "+Flag" or "-Flag"), so flags travel with the cluster
+	// definition; the KOPS_FEATURE_FLAGS environment variable still
+	// overrides them
+	FeatureGates []string `json:"featureGates,omitempty"`
+}
+
+// CloudLabelsPolicySpec controls which cloud resource types the user-specified CloudLabels
+// are propagated to.  All fields default to true.  These are the only taggable resource
+// types kops manages: launch configurations cannot carry tags in AWS, and kops does not
+// create ENIs or snapshots.
+type CloudLabelsPolicySpec struct {
+	// Volumes controls whether CloudLabels are applied to the etcd volumes
+	Volumes *bool `json:"volumes,omitempty"`
+	// InstanceGroups controls whether cluster-level CloudLabels are applied to instance groups
+	InstanceGroups *bool `json:"instanceGroups,omitempty"`
 }
 
 type HookSpec struct {
 	ExecContainer *ExecContainerAction `json:"execContainer,omitempty"`
 }
 
+// ComponentPatchSpec is a strategic-merge patch applied to one generated manifest
+type ComponentPatchSpec struct {
+	// Component is the base name of the generated manifest to patch (e.g. "kube-apiserver")
+	Component string `json:"component,omitempty"`
+	// Patch is the patch document, as YAML or JSON
+	Patch string `json:"patch,omitempty"`
+}
+
+// AdoptSpec references existing cloud resources to adopt: kops uses them as-is
+// instead of creating its own, and does not modify or delete them
+type AdoptSpec struct {
+	// MastersSecurityGroup is the id of an existing security group for the masters
+	MastersSecurityGroup string `json:"mastersSecurityGroup,omitempty"`
+	// NodesSecurityGroup is the id of an existing security group for the nodes
+	NodesSecurityGroup string `json:"nodesSecurityGroup,omitempty"`
+	// MastersInstanceProfile is the name or ARN of an existing IAM instance profile for the masters
+	MastersInstanceProfile string `json:"mastersInstanceProfile,omitempty"`
+	// NodesInstanceProfile is the name or ARN of an existing IAM instance profile for the nodes
+	NodesInstanceProfile string `json:"nodesInstanceProfile,omitempty"`
+	// APILoadBalancer is the name of an existing ELB to use for the API
+	APILoadBalancer string `json:"apiLoadBalancer,omitempty"`
+}
+
+// VerificationCheckSpec describes a single post-rolling-update verification
+// check.  Set the fields matching Type; the others are ignored.
+type VerificationCheckSpec struct {
+	// Name identifies the check in the verification report
+	Name string `json:"name,omitempty"`
+	// Type is the kind of check to run: http, job or prometheus
+	Type string `json:"type,omitempty"`
+	// URL is fetched for http checks; any 2xx response passes
+	URL string `json:"url,omitempty"`
+	// PrometheusURL is the base URL of the prometheus server to query
+	PrometheusURL string `json:"prometheusURL,omitempty"`
+	// Query is the prometheus expression; the check passes if the query
+	// returns at least one sample and no sample is zero
+	Query string `json:"query,omitempty"`
+	// Image is the image of a Job run in kube-system for job checks
+	Image string `json:"image,omitempty"`
+	// Command is the command for the verification Job
+	Command []string `json:"command,omitempty"`
+	// TimeoutSeconds bounds the check; defaults to 300
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ValidationSpec configures extra gates evaluated by `kops validate cluster`
+// and by the validation between rolling-update instance replacements.
+type ValidationSpec struct {
+	// MetricGates are external metric queries that must stay within their
+	// thresholds for the cluster to validate
+	MetricGates []MetricGateSpec `json:"metricGates,omitempty"`
+}
+
+// MetricGateSpec is a prometheus query with a threshold; the gate fails when
+// the query result crosses it.
+type MetricGateSpec struct {
+	// Name identifies the gate in validation failures
+	Name string `json:"name,omitempty"`
+	// PrometheusURL is the base URL of the prometheus server to query
+	PrometheusURL string `json:"prometheusURL,omitempty"`
+	// Query is the prometheus expression; its first sample is compared
+	// against the thresholds
+	Query string `json:"query,omitempty"`
+	// MaxValue fails the gate when the result is greater than this value
+	MaxValue string `json:"maxValue,omitempty"`
+	// MinValue fails the gate when the result is less than this value
+	MinValue string `json:"minValue,omitempty"`
+}
+
 type ExecContainerAction struct {
 	// Docker image name.
 	Image string `json:"image,omitempty" `
@@ -211,6 +315,11 @@ type AlwaysAllowAuthorizationSpec struct {
 type AccessSpec struct {
 	DNS          *DNSAccessSpec          `json:"dns,omitempty"`
 	LoadBalancer *LoadBalancerAccessSpec `json:"loadBalancer,omitempty"`
+
+	// SplitHorizon also publishes the public API name inside the network,
+	// resolving to the masters' private IPs; clients on a VPN reach the API
+	// directly while internet clients keep using the public endpoint
+	SplitHorizon *bool `json:"splitHorizon,omitempty"`
 }
 
 func (s *AccessSpec) IsEmpty() bool {
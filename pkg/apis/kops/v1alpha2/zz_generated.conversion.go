@@ -37,6 +37,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedConversionFuncs(
 		Convert_v1alpha2_AccessSpec_To_kops_AccessSpec,
 		Convert_kops_AccessSpec_To_v1alpha2_AccessSpec,
+		Convert_v1alpha2_AdoptSpec_To_kops_AdoptSpec,
+		Convert_kops_AdoptSpec_To_v1alpha2_AdoptSpec,
 		Convert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec,
 		Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec,
 		Convert_v1alpha2_AuthenticationSpec_To_kops_AuthenticationSpec,
@@ -55,12 +57,16 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_kops_ClassicNetworkingSpec_To_v1alpha2_ClassicNetworkingSpec,
 		Convert_v1alpha2_CloudConfiguration_To_kops_CloudConfiguration,
 		Convert_kops_CloudConfiguration_To_v1alpha2_CloudConfiguration,
+		Convert_v1alpha2_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec,
+		Convert_kops_CloudLabelsPolicySpec_To_v1alpha2_CloudLabelsPolicySpec,
 		Convert_v1alpha2_Cluster_To_kops_Cluster,
 		Convert_kops_Cluster_To_v1alpha2_Cluster,
 		Convert_v1alpha2_ClusterList_To_kops_ClusterList,
 		Convert_kops_ClusterList_To_v1alpha2_ClusterList,
 		Convert_v1alpha2_ClusterSpec_To_kops_ClusterSpec,
 		Convert_kops_ClusterSpec_To_v1alpha2_ClusterSpec,
+		Convert_v1alpha2_ComponentPatchSpec_To_kops_ComponentPatchSpec,
+		Convert_kops_ComponentPatchSpec_To_v1alpha2_ComponentPatchSpec,
 		Convert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec,
 		Convert_kops_ClusterSubnetSpec_To_v1alpha2_ClusterSubnetSpec,
 		Convert_v1alpha2_DNSAccessSpec_To_kops_DNSAccessSpec,
@@ -117,12 +123,22 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_kops_LeaderElectionConfiguration_To_v1alpha2_LeaderElectionConfiguration,
 		Convert_v1alpha2_LoadBalancerAccessSpec_To_kops_LoadBalancerAccessSpec,
 		Convert_kops_LoadBalancerAccessSpec_To_v1alpha2_LoadBalancerAccessSpec,
+		Convert_v1alpha2_MetricGateSpec_To_kops_MetricGateSpec,
+		Convert_kops_MetricGateSpec_To_v1alpha2_MetricGateSpec,
 		Convert_v1alpha2_NetworkingSpec_To_kops_NetworkingSpec,
 		Convert_kops_NetworkingSpec_To_v1alpha2_NetworkingSpec,
 		Convert_v1alpha2_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec,
 		Convert_kops_RBACAuthorizationSpec_To_v1alpha2_RBACAuthorizationSpec,
+		Convert_v1alpha2_RollingUpdateSpec_To_kops_RollingUpdateSpec,
+		Convert_kops_RollingUpdateSpec_To_v1alpha2_RollingUpdateSpec,
+		Convert_v1alpha2_ScheduledActionSpec_To_kops_ScheduledActionSpec,
+		Convert_kops_ScheduledActionSpec_To_v1alpha2_ScheduledActionSpec,
 		Convert_v1alpha2_TopologySpec_To_kops_TopologySpec,
 		Convert_kops_TopologySpec_To_v1alpha2_TopologySpec,
+		Convert_v1alpha2_ValidationSpec_To_kops_ValidationSpec,
+		Convert_kops_ValidationSpec_To_v1alpha2_ValidationSpec,
+		Convert_v1alpha2_VerificationCheckSpec_To_kops_VerificationCheckSpec,
+		Convert_kops_VerificationCheckSpec_To_v1alpha2_VerificationCheckSpec,
 		Convert_v1alpha2_WeaveNetworkingSpec_To_kops_WeaveNetworkingSpec,
 		Convert_kops_WeaveNetworkingSpec_To_v1alpha2_WeaveNetworkingSpec,
 	)
@@ -147,6 +163,7 @@ func autoConvert_v1alpha2_AccessSpec_To_kops_AccessSpec(in *AccessSpec, out *kop
 	} else {
 		out.LoadBalancer = nil
 	}
+	out.SplitHorizon = in.SplitHorizon
 	return nil
 }
 
@@ -174,6 +191,7 @@ func autoConvert_kops_AccessSpec_To_v1alpha2_AccessSpec(in *kops.AccessSpec, out
 	} else {
 		out.LoadBalancer = nil
 	}
+	out.SplitHorizon = in.SplitHorizon
 	return nil
 }
 
@@ -182,6 +200,34 @@ func Convert_kops_AccessSpec_To_v1alpha2_AccessSpec(in *kops.AccessSpec, out *Ac
 	return autoConvert_kops_AccessSpec_To_v1alpha2_AccessSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_AdoptSpec_To_kops_AdoptSpec(in *AdoptSpec, out *kops.AdoptSpec, s conversion.Scope) error {
+	out.MastersSecurityGroup = in.MastersSecurityGroup
+	out.NodesSecurityGroup = in.NodesSecurityGroup
+	out.MastersInstanceProfile = in.MastersInstanceProfile
+	out.NodesInstanceProfile = in.NodesInstanceProfile
+	out.APILoadBalancer = in.APILoadBalancer
+	return nil
+}
+
+// Convert_v1alpha2_AdoptSpec_To_kops_AdoptSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AdoptSpec_To_kops_AdoptSpec(in *AdoptSpec, out *kops.AdoptSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AdoptSpec_To_kops_AdoptSpec(in, out, s)
+}
+
+func autoConvert_kops_AdoptSpec_To_v1alpha2_AdoptSpec(in *kops.AdoptSpec, out *AdoptSpec, s conversion.Scope) error {
+	out.MastersSecurityGroup = in.MastersSecurityGroup
+	out.NodesSecurityGroup = in.NodesSecurityGroup
+	out.MastersInstanceProfile = in.MastersInstanceProfile
+	out.NodesInstanceProfile = in.NodesInstanceProfile
+	out.APILoadBalancer = in.APILoadBalancer
+	return nil
+}
+
+// Convert_kops_AdoptSpec_To_v1alpha2_AdoptSpec is an autogenerated conversion function.
+func Convert_kops_AdoptSpec_To_v1alpha2_AdoptSpec(in *kops.AdoptSpec, out *AdoptSpec, s conversion.Scope) error {
+	return autoConvert_kops_AdoptSpec_To_v1alpha2_AdoptSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -426,6 +472,28 @@ func Convert_kops_CloudConfiguration_To_v1alpha2_CloudConfiguration(in *kops.Clo
 	return autoConvert_kops_CloudConfiguration_To_v1alpha2_CloudConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha2_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(in *CloudLabelsPolicySpec, out *kops.CloudLabelsPolicySpec, s conversion.Scope) error {
+	out.Volumes = in.Volumes
+	out.InstanceGroups = in.InstanceGroups
+	return nil
+}
+
+// Convert_v1alpha2_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha2_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(in *CloudLabelsPolicySpec, out *kops.CloudLabelsPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(in, out, s)
+}
+
+func autoConvert_kops_CloudLabelsPolicySpec_To_v1alpha2_CloudLabelsPolicySpec(in *kops.CloudLabelsPolicySpec, out *CloudLabelsPolicySpec, s conversion.Scope) error {
+	out.Volumes = in.Volumes
+	out.InstanceGroups = in.InstanceGroups
+	return nil
+}
+
+// Convert_kops_CloudLabelsPolicySpec_To_v1alpha2_CloudLabelsPolicySpec is an autogenerated conversion function.
+func Convert_kops_CloudLabelsPolicySpec_To_v1alpha2_CloudLabelsPolicySpec(in *kops.CloudLabelsPolicySpec, out *CloudLabelsPolicySpec, s conversion.Scope) error {
+	return autoConvert_kops_CloudLabelsPolicySpec_To_v1alpha2_CloudLabelsPolicySpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_Cluster_To_kops_Cluster(in *Cluster, out *kops.Cluster, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -666,6 +734,15 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 		out.Authorization = nil
 	}
 	out.CloudLabels = in.CloudLabels
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(kops.CloudLabelsPolicySpec)
+		if err := Convert_v1alpha2_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = make([]kops.HookSpec, len(*in))
@@ -677,6 +754,47 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.Hooks = nil
 	}
+	if in.ComponentPatches != nil {
+		in, out := &in.ComponentPatches, &out.ComponentPatches
+		*out = make([]kops.ComponentPatchSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_ComponentPatchSpec_To_kops_ComponentPatchSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ComponentPatches = nil
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(kops.AdoptSpec)
+		if err := Convert_v1alpha2_AdoptSpec_To_kops_AdoptSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Adopt = nil
+	}
+	if in.RollingUpdateVerification != nil {
+		in, out := &in.RollingUpdateVerification, &out.RollingUpdateVerification
+		*out = make([]kops.VerificationCheckSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_VerificationCheckSpec_To_kops_VerificationCheckSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RollingUpdateVerification = nil
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(kops.ValidationSpec)
+		if err := Convert_v1alpha2_ValidationSpec_To_kops_ValidationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Validation = nil
+	}
+	out.FeatureGates = in.FeatureGates
 	return nil
 }
 
@@ -857,6 +975,15 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 		out.Authorization = nil
 	}
 	out.CloudLabels = in.CloudLabels
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(CloudLabelsPolicySpec)
+		if err := Convert_kops_CloudLabelsPolicySpec_To_v1alpha2_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = make([]HookSpec, len(*in))
@@ -868,6 +995,47 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.Hooks = nil
 	}
+	if in.ComponentPatches != nil {
+		in, out := &in.ComponentPatches, &out.ComponentPatches
+		*out = make([]ComponentPatchSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_ComponentPatchSpec_To_v1alpha2_ComponentPatchSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ComponentPatches = nil
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(AdoptSpec)
+		if err := Convert_kops_AdoptSpec_To_v1alpha2_AdoptSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Adopt = nil
+	}
+	if in.RollingUpdateVerification != nil {
+		in, out := &in.RollingUpdateVerification, &out.RollingUpdateVerification
+		*out = make([]VerificationCheckSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_VerificationCheckSpec_To_v1alpha2_VerificationCheckSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RollingUpdateVerification = nil
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(ValidationSpec)
+		if err := Convert_kops_ValidationSpec_To_v1alpha2_ValidationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Validation = nil
+	}
+	out.FeatureGates = in.FeatureGates
 	return nil
 }
 
@@ -1032,6 +1200,28 @@ func Convert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in *kops.EtcdClust
 	return autoConvert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_ComponentPatchSpec_To_kops_ComponentPatchSpec(in *ComponentPatchSpec, out *kops.ComponentPatchSpec, s conversion.Scope) error {
+	out.Component = in.Component
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_v1alpha2_ComponentPatchSpec_To_kops_ComponentPatchSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ComponentPatchSpec_To_kops_ComponentPatchSpec(in *ComponentPatchSpec, out *kops.ComponentPatchSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ComponentPatchSpec_To_kops_ComponentPatchSpec(in, out, s)
+}
+
+func autoConvert_kops_ComponentPatchSpec_To_v1alpha2_ComponentPatchSpec(in *kops.ComponentPatchSpec, out *ComponentPatchSpec, s conversion.Scope) error {
+	out.Component = in.Component
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_kops_ComponentPatchSpec_To_v1alpha2_ComponentPatchSpec is an autogenerated conversion function.
+func Convert_kops_ComponentPatchSpec_To_v1alpha2_ComponentPatchSpec(in *kops.ComponentPatchSpec, out *ComponentPatchSpec, s conversion.Scope) error {
+	return autoConvert_kops_ComponentPatchSpec_To_v1alpha2_ComponentPatchSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_EtcdMemberSpec_To_kops_EtcdMemberSpec(in *EtcdMemberSpec, out *kops.EtcdMemberSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.InstanceGroup = in.InstanceGroup
@@ -1342,6 +1532,39 @@ func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(kops.CloudLabelsPolicySpec)
+		if err := Convert_v1alpha2_CloudLabelsPolicySpec_To_kops_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
+	if in.ScheduledActions != nil {
+		in, out := &in.ScheduledActions, &out.ScheduledActions
+		*out = make([]kops.ScheduledActionSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_ScheduledActionSpec_To_kops_ScheduledActionSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScheduledActions = nil
+	}
+	out.ExpiresAt = in.ExpiresAt
+	out.PrePullImages = in.PrePullImages
+	out.MinHealthyPercent = in.MinHealthyPercent
+	out.MaxInstanceLifetime = in.MaxInstanceLifetime
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(kops.RollingUpdateSpec)
+		if err := Convert_v1alpha2_RollingUpdateSpec_To_kops_RollingUpdateSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -1376,6 +1599,39 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.I
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	if in.CloudLabelsPolicy != nil {
+		in, out := &in.CloudLabelsPolicy, &out.CloudLabelsPolicy
+		*out = new(CloudLabelsPolicySpec)
+		if err := Convert_kops_CloudLabelsPolicySpec_To_v1alpha2_CloudLabelsPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudLabelsPolicy = nil
+	}
+	if in.ScheduledActions != nil {
+		in, out := &in.ScheduledActions, &out.ScheduledActions
+		*out = make([]ScheduledActionSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_ScheduledActionSpec_To_v1alpha2_ScheduledActionSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScheduledActions = nil
+	}
+	out.ExpiresAt = in.ExpiresAt
+	out.PrePullImages = in.PrePullImages
+	out.MinHealthyPercent = in.MinHealthyPercent
+	out.MaxInstanceLifetime = in.MaxInstanceLifetime
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateSpec)
+		if err := Convert_kops_RollingUpdateSpec_To_v1alpha2_RollingUpdateSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -1437,6 +1693,8 @@ func autoConvert_v1alpha2_KubeAPIServerConfig_To_kops_KubeAPIServerConfig(in *Ku
 	out.TLSPrivateKeyFile = in.TLSPrivateKeyFile
 	out.KubeletClientCertificate = in.KubeletClientCertificate
 	out.KubeletClientKey = in.KubeletClientKey
+	out.SSHUser = in.SSHUser
+	out.SSHKeyfile = in.SSHKeyfile
 	out.TokenAuthFile = in.TokenAuthFile
 	out.AllowPrivileged = in.AllowPrivileged
 	out.APIServerCount = in.APIServerCount
@@ -1484,6 +1742,8 @@ func autoConvert_kops_KubeAPIServerConfig_To_v1alpha2_KubeAPIServerConfig(in *ko
 	out.TLSPrivateKeyFile = in.TLSPrivateKeyFile
 	out.KubeletClientCertificate = in.KubeletClientCertificate
 	out.KubeletClientKey = in.KubeletClientKey
+	out.SSHUser = in.SSHUser
+	out.SSHKeyfile = in.SSHKeyfile
 	out.TokenAuthFile = in.TokenAuthFile
 	out.AllowPrivileged = in.AllowPrivileged
 	out.APIServerCount = in.APIServerCount
@@ -1852,6 +2112,34 @@ func Convert_kops_LoadBalancerAccessSpec_To_v1alpha2_LoadBalancerAccessSpec(in *
 	return autoConvert_kops_LoadBalancerAccessSpec_To_v1alpha2_LoadBalancerAccessSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_MetricGateSpec_To_kops_MetricGateSpec(in *MetricGateSpec, out *kops.MetricGateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.MaxValue = in.MaxValue
+	out.MinValue = in.MinValue
+	return nil
+}
+
+// Convert_v1alpha2_MetricGateSpec_To_kops_MetricGateSpec is an autogenerated conversion function.
+func Convert_v1alpha2_MetricGateSpec_To_kops_MetricGateSpec(in *MetricGateSpec, out *kops.MetricGateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MetricGateSpec_To_kops_MetricGateSpec(in, out, s)
+}
+
+func autoConvert_kops_MetricGateSpec_To_v1alpha2_MetricGateSpec(in *kops.MetricGateSpec, out *MetricGateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.MaxValue = in.MaxValue
+	out.MinValue = in.MinValue
+	return nil
+}
+
+// Convert_kops_MetricGateSpec_To_v1alpha2_MetricGateSpec is an autogenerated conversion function.
+func Convert_kops_MetricGateSpec_To_v1alpha2_MetricGateSpec(in *kops.MetricGateSpec, out *MetricGateSpec, s conversion.Scope) error {
+	return autoConvert_kops_MetricGateSpec_To_v1alpha2_MetricGateSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_NetworkingSpec_To_kops_NetworkingSpec(in *NetworkingSpec, out *kops.NetworkingSpec, s conversion.Scope) error {
 	if in.Classic != nil {
 		in, out := &in.Classic, &out.Classic
@@ -2068,6 +2356,52 @@ func Convert_kops_RBACAuthorizationSpec_To_v1alpha2_RBACAuthorizationSpec(in *ko
 	return autoConvert_kops_RBACAuthorizationSpec_To_v1alpha2_RBACAuthorizationSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_RollingUpdateSpec_To_kops_RollingUpdateSpec(in *RollingUpdateSpec, out *kops.RollingUpdateSpec, s conversion.Scope) error {
+	out.MaxSurge = in.MaxSurge
+	return nil
+}
+
+// Convert_v1alpha2_RollingUpdateSpec_To_kops_RollingUpdateSpec is an autogenerated conversion function.
+func Convert_v1alpha2_RollingUpdateSpec_To_kops_RollingUpdateSpec(in *RollingUpdateSpec, out *kops.RollingUpdateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_RollingUpdateSpec_To_kops_RollingUpdateSpec(in, out, s)
+}
+
+func autoConvert_kops_RollingUpdateSpec_To_v1alpha2_RollingUpdateSpec(in *kops.RollingUpdateSpec, out *RollingUpdateSpec, s conversion.Scope) error {
+	out.MaxSurge = in.MaxSurge
+	return nil
+}
+
+// Convert_kops_RollingUpdateSpec_To_v1alpha2_RollingUpdateSpec is an autogenerated conversion function.
+func Convert_kops_RollingUpdateSpec_To_v1alpha2_RollingUpdateSpec(in *kops.RollingUpdateSpec, out *RollingUpdateSpec, s conversion.Scope) error {
+	return autoConvert_kops_RollingUpdateSpec_To_v1alpha2_RollingUpdateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_ScheduledActionSpec_To_kops_ScheduledActionSpec(in *ScheduledActionSpec, out *kops.ScheduledActionSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.MinSize = in.MinSize
+	out.MaxSize = in.MaxSize
+	out.DesiredCapacity = in.DesiredCapacity
+	return nil
+}
+
+// Convert_v1alpha2_ScheduledActionSpec_To_kops_ScheduledActionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ScheduledActionSpec_To_kops_ScheduledActionSpec(in *ScheduledActionSpec, out *kops.ScheduledActionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ScheduledActionSpec_To_kops_ScheduledActionSpec(in, out, s)
+}
+
+func autoConvert_kops_ScheduledActionSpec_To_v1alpha2_ScheduledActionSpec(in *kops.ScheduledActionSpec, out *ScheduledActionSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.MinSize = in.MinSize
+	out.MaxSize = in.MaxSize
+	out.DesiredCapacity = in.DesiredCapacity
+	return nil
+}
+
+// Convert_kops_ScheduledActionSpec_To_v1alpha2_ScheduledActionSpec is an autogenerated conversion function.
+func Convert_kops_ScheduledActionSpec_To_v1alpha2_ScheduledActionSpec(in *kops.ScheduledActionSpec, out *ScheduledActionSpec, s conversion.Scope) error {
+	return autoConvert_kops_ScheduledActionSpec_To_v1alpha2_ScheduledActionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_TopologySpec_To_kops_TopologySpec(in *TopologySpec, out *kops.TopologySpec, s conversion.Scope) error {
 	out.Masters = in.Masters
 	out.Nodes = in.Nodes
@@ -2126,6 +2460,80 @@ func Convert_kops_TopologySpec_To_v1alpha2_TopologySpec(in *kops.TopologySpec, o
 	return autoConvert_kops_TopologySpec_To_v1alpha2_TopologySpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_ValidationSpec_To_kops_ValidationSpec(in *ValidationSpec, out *kops.ValidationSpec, s conversion.Scope) error {
+	if in.MetricGates != nil {
+		in, out := &in.MetricGates, &out.MetricGates
+		*out = make([]kops.MetricGateSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_MetricGateSpec_To_kops_MetricGateSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MetricGates = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_ValidationSpec_To_kops_ValidationSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ValidationSpec_To_kops_ValidationSpec(in *ValidationSpec, out *kops.ValidationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ValidationSpec_To_kops_ValidationSpec(in, out, s)
+}
+
+func autoConvert_kops_ValidationSpec_To_v1alpha2_ValidationSpec(in *kops.ValidationSpec, out *ValidationSpec, s conversion.Scope) error {
+	if in.MetricGates != nil {
+		in, out := &in.MetricGates, &out.MetricGates
+		*out = make([]MetricGateSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_MetricGateSpec_To_v1alpha2_MetricGateSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MetricGates = nil
+	}
+	return nil
+}
+
+// Convert_kops_ValidationSpec_To_v1alpha2_ValidationSpec is an autogenerated conversion function.
+func Convert_kops_ValidationSpec_To_v1alpha2_ValidationSpec(in *kops.ValidationSpec, out *ValidationSpec, s conversion.Scope) error {
+	return autoConvert_kops_ValidationSpec_To_v1alpha2_ValidationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_VerificationCheckSpec_To_kops_VerificationCheckSpec(in *VerificationCheckSpec, out *kops.VerificationCheckSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.URL = in.URL
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.Image = in.Image
+	out.Command = in.Command
+	out.TimeoutSeconds = in.TimeoutSeconds
+	return nil
+}
+
+// Convert_v1alpha2_VerificationCheckSpec_To_kops_VerificationCheckSpec is an autogenerated conversion function.
+func Convert_v1alpha2_VerificationCheckSpec_To_kops_VerificationCheckSpec(in *VerificationCheckSpec, out *kops.VerificationCheckSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VerificationCheckSpec_To_kops_VerificationCheckSpec(in, out, s)
+}
+
+func autoConvert_kops_VerificationCheckSpec_To_v1alpha2_VerificationCheckSpec(in *kops.VerificationCheckSpec, out *VerificationCheckSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.URL = in.URL
+	out.PrometheusURL = in.PrometheusURL
+	out.Query = in.Query
+	out.Image = in.Image
+	out.Command = in.Command
+	out.TimeoutSeconds = in.TimeoutSeconds
+	return nil
+}
+
+// Convert_kops_VerificationCheckSpec_To_v1alpha2_VerificationCheckSpec is an autogenerated conversion function.
+func Convert_kops_VerificationCheckSpec_To_v1alpha2_VerificationCheckSpec(in *kops.VerificationCheckSpec, out *VerificationCheckSpec, s conversion.Scope) error {
+	return autoConvert_kops_VerificationCheckSpec_To_v1alpha2_VerificationCheckSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_WeaveNetworkingSpec_To_kops_WeaveNetworkingSpec(in *WeaveNetworkingSpec, out *kops.WeaveNetworkingSpec, s conversion.Scope) error {
 	out.MTU = in.MTU
 	return nil
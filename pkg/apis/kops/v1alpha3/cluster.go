@@ -0,0 +1,395 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient=true
+
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterSpec `json:"spec,omitempty"`
+}
+
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Cluster `json:"items"`
+}
+
+type ClusterSpec struct {
+	// The Channel we are following
+	Channel string `json:"channel,omitempty"`
+
+	// ConfigBase is the path where we store configuration for the cluster
+	// This might be different that the location when the cluster spec itself is stored,
+	// both because this must be accessible to the cluster,
+	// and because it might be on a different cloud or storage system (etcd vs S3)
+	ConfigBase string `json:"configBase,omitempty"`
+
+	// The CloudProvider to use (aws or gce)
+	CloudProvider string `json:"cloudProvider,omitempty"`
+
+	// The version of kubernetes to install (optional, and can be a "spec" like stable)
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	//
+	//// The Node initializer technique to use: cloudinit or nodeup
+	//NodeInit                      string `json:",omitempty"`
+
+	// Configuration of subnets we are targeting
+	Subnets []ClusterSubnetSpec `json:"subnets,omitempty"`
+
+	// Project is the cloud project we should use, required on GCE
+	Project string `json:"project,omitempty"`
+
+	// MasterPublicName is the external DNS name for the master nodes
+	MasterPublicName string `json:"masterPublicName,omitempty"`
+	// MasterInternalName is the internal DNS name for the master nodes
+	MasterInternalName string `json:"masterInternalName,omitempty"`
+
+	// The CIDR used for the AWS VPC / GCE Network, or otherwise allocated to k8s
+	// This is a real CIDR, not the internal k8s network
+	NetworkCIDR string `json:"networkCIDR,omitempty"`
+
+	// NetworkID is an identifier of a network, if we want to reuse/share an existing network (e.g. an AWS VPC)
+	NetworkID string `json:"networkID,omitempty"`
+
+	// Topology defines the type of network topology to use on the cluster - default public
+	// This is heavily weighted towards AWS for the time being, but should also be agnostic enough
+	// to port out to GCE later if needed
+	Topology *TopologySpec `json:"topology,omitempty"`
+
+	// SecretStore is the VFS path to where secrets are stored
+	SecretStore string `json:"secretStore,omitempty"`
+	// KeyStore is the VFS path to where SSL keys and certificates are stored
+	KeyStore string `json:"keyStore,omitempty"`
+	// ConfigStore is the VFS path to where the configuration (Cluster, InstanceGroups etc) is stored
+	ConfigStore string `json:"configStore,omitempty"`
+
+	// DNSZone is the DNS zone we should use when configuring DNS
+	// This is because some clouds let us define a managed zone foo.bar, and then have
+	// kubernetes.dev.foo.bar, without needing to define dev.foo.bar as a hosted zone.
+	// DNSZone will probably be a suffix of the MasterPublicName and MasterInternalName
+	// Note that DNSZone can either by the host name of the zone (containing dots),
+	// or can be an identifier for the zone.
+	DNSZone string `json:"dnsZone,omitempty"`
+
+	// ClusterDNSDomain is the suffix we use for internal DNS names (normally cluster.local)
+	ClusterDNSDomain string `json:"clusterDNSDomain,omitempty"`
+
+	//InstancePrefix                string `json:",omitempty"`
+
+	// ClusterName is a unique identifier for the cluster, and currently must be a DNS name
+	//ClusterName       string `json:",omitempty"`
+
+	//ClusterIPRange                string `json:",omitempty"`
+
+	// ServiceClusterIPRange is the CIDR, from the internal network, where we allocate IPs for services
+	ServiceClusterIPRange string `json:"serviceClusterIPRange,omitempty"`
+	//MasterIPRange                 string `json:",omitempty"`
+
+	// NonMasqueradeCIDR is the CIDR for the internal k8s network (on which pods & services live)
+	// It cannot overlap ServiceClusterIPRange
+	NonMasqueradeCIDR string `json:"nonMasqueradeCIDR,omitempty"`
+
+	// SSHAccess determines the permitted access to SSH
+	// Currently only a single CIDR is supported (though a richer grammar could be added in future)
+	SSHAccess []string `json:"sshAccess,omitempty"`
+
+	// KubernetesAPIAccess determines the permitted access to the API endpoints (master HTTPS)
+	// Currently only a single CIDR is supported (though a richer grammar could be added in future)
+	KubernetesAPIAccess []string `json:"kubernetesApiAccess,omitempty"`
+
+	// IsolatesMasters determines whether we should lock down masters so that they are not on the pod network.
+	// true is the kube-up behaviour, but it is very surprising: it means that daemonsets only work on the master
+	// if they have hostNetwork=true.
+	// false is now the default, and it will:
+	//  * give the master a normal PodCIDR
+	//  * run kube-proxy on the master
+	//  * enable debugging handlers on the master, so kubectl logs works
+	IsolateMasters *bool `json:"isolateMasters,omitempty"`
+
+	// UpdatePolicy determines the policy for applying upgrades automatically.
+	// Valid values:
+	//   'external' do not apply updates automatically - they are applied manually or by an external system
+	//   missing: default policy (currently OS security upgrades that do not require a reboot)
+	UpdatePolicy *string `json:"updatePolicy,omitempty"`
+
+	// Additional policies to add for roles
+	AdditionalPolicies *map[string]string `json:"additionalPolicies,omitempty"`
+
+	// EtcdClusters stores the configuration for each cluster
+	EtcdClusters []*EtcdClusterSpec `json:"etcdClusters,omitempty"`
+
+	// Component configurations
+	Docker                *DockerConfig                `json:"docker,omitempty"`
+	KubeDNS               *KubeDNSConfig               `json:"kubeDNS,omitempty"`
+	KubeAPIServer         *KubeAPIServerConfig         `json:"kubeAPIServer,omitempty"`
+	KubeControllerManager *KubeControllerManagerConfig `json:"kubeControllerManager,omitempty"`
+	KubeScheduler         *KubeSchedulerConfig         `json:"kubeScheduler,omitempty"`
+	KubeProxy             *KubeProxyConfig             `json:"kubeProxy,omitempty"`
+	Kubelet               *KubeletConfigSpec           `json:"kubelet,omitempty"`
+	MasterKubelet         *KubeletConfigSpec           `json:"masterKubelet,omitempty"`
+	CloudConfig           *CloudConfiguration          `json:"cloudConfig,omitempty"`
+
+	// Networking configuration
+	Networking *NetworkingSpec `json:"networking,omitempty"`
+
+	// API field controls how the API is exposed outside the cluster
+	API *AccessSpec `json:"api,omitempty"`
+
+	// Authentication field controls how the cluster is configured for authentication
+	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
+
+	// Authorization field controls how the cluster is configured for authorization
+	Authorization *AuthorizationSpec `json:"authorization,omitempty"`
+
+	// Tags for AWS resources
+	CloudLabels map[string]string `json:"cloudLabels,omitempty"`
+
+	// CloudLabelsPolicy controls which cloud resource types the CloudLabels are applied to
+	CloudLabelsPolicy *CloudLabelsPolicySpec `json:"cloudLabelsPolicy,omitempty"`
+
+	// Hooks for custom actions e.g. on first installation
+	Hooks []HookSpec `json:"hooks,omitempty"`
+	// ComponentPatches are strategic-merge patches applied to the generated
+	// static pod manifests at render time; an escape hatch for settings that
+	// kops does not yet model
+	ComponentPatches []ComponentPatchSpec `json:"componentPatches,omitempty"`
+
+	// Adopt references existing cloud resources that kops should use without
+	// managing their lifecycle
+	Adopt *AdoptSpec `json:"adopt,omitempty"`
+
+	// RollingUpdateVerification is a list of checks run after a rolling update
+	// completes; the update is not reported as successful until they all pass
+	RollingUpdateVerification []VerificationCheckSpec `json:"rollingUpdateVerification,omitempty"`
+
+	// Validation configures extra gates evaluated by cluster validation
+	Validation *ValidationSpec `json:"validation,omitempty"`
+
+	// FeatureGates lists kops feature flags to apply for this cluster
+	// ("Flag", "+Flag" or "-Flag"), so flags travel with the cluster
+	// definition; the KOPS_FEATURE_FLAGS environment variable still
+	// overrides them
+	FeatureGates []string `json:"featureGates,omitempty"`
+}
+
+// CloudLabelsPolicySpec controls which cloud resource types the user-specified CloudLabels
+// are propagated to.  All fields default to true.  These are the only taggable resource
+// types kops manages: launch configurations cannot carry tags in AWS, and kops does not
+// create ENIs or snapshots.
+type CloudLabelsPolicySpec struct {
+	// Volumes controls whether CloudLabels are applied to the etcd volumes
+	Volumes *bool `json:"volumes,omitempty"`
+	// InstanceGroups controls whether cluster-level CloudLabels are applied to instance groups
+	InstanceGroups *bool `json:"instanceGroups,omitempty"`
+}
+
+type HookSpec struct {
+	ExecContainer *ExecContainerAction `json:"execContainer,omitempty"`
+}
+
+// ComponentPatchSpec is a strategic-merge patch applied to one generated manifest
+type ComponentPatchSpec struct {
+	// Component is the base name of the generated manifest to patch (e.g. "kube-apiserver")
+	Component string `json:"component,omitempty"`
+	// Patch is the patch document, as YAML or JSON
+	Patch string `json:"patch,omitempty"`
+}
+
+// AdoptSpec references existing cloud resources to adopt: kops uses them as-is
+// instead of creating its own, and does not modify or delete them
+type AdoptSpec struct {
+	// MastersSecurityGroup is the id of an existing security group for the masters
+	MastersSecurityGroup string `json:"mastersSecurityGroup,omitempty"`
+	// NodesSecurityGroup is the id of an existing security group for the nodes
+	NodesSecurityGroup string `json:"nodesSecurityGroup,omitempty"`
+	// MastersInstanceProfile is the name or ARN of an existing IAM instance profile for the masters
+	MastersInstanceProfile string `json:"mastersInstanceProfile,omitempty"`
+	// NodesInstanceProfile is the name or ARN of an existing IAM instance profile for the nodes
+	NodesInstanceProfile string `json:"nodesInstanceProfile,omitempty"`
+	// APILoadBalancer is the name of an existing ELB to use for the API
+	APILoadBalancer string `json:"apiLoadBalancer,omitempty"`
+}
+
+// VerificationCheckSpec describes a single post-rolling-update verification
+// check.  Set the fields matching Type; the others are ignored.
+type VerificationCheckSpec struct {
+	// Name identifies the check in the verification report
+	Name string `json:"name,omitempty"`
+	// Type is the kind of check to run: http, job or prometheus
+	Type string `json:"type,omitempty"`
+	// URL is fetched for http checks; any 2xx response passes
+	URL string `json:"url,omitempty"`
+	// PrometheusURL is the base URL of the prometheus server to query
+	PrometheusURL string `json:"prometheusURL,omitempty"`
+	// Query is the prometheus expression; the check passes if the query
+	// returns at least one sample and no sample is zero
+	Query string `json:"query,omitempty"`
+	// Image is the image of a Job run in kube-system for job checks
+	Image string `json:"image,omitempty"`
+	// Command is the command for the verification Job
+	Command []string `json:"command,omitempty"`
+	// TimeoutSeconds bounds the check; defaults to 300
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ValidationSpec configures extra gates evaluated by `kops validate cluster`
+// and by the validation between rolling-update instance replacements.
+type ValidationSpec struct {
+	// MetricGates are external metric queries that must stay within their
+	// thresholds for the cluster to validate
+	MetricGates []MetricGateSpec `json:"metricGates,omitempty"`
+}
+
+// MetricGateSpec is a prometheus query with a threshold; the gate fails when
+// the query result crosses it.
+type MetricGateSpec struct {
+	// Name identifies the gate in validation failures
+	Name string `json:"name,omitempty"`
+	// PrometheusURL is the base URL of the prometheus server to query
+	PrometheusURL string `json:"prometheusURL,omitempty"`
+	// Query is the prometheus expression; its first sample is compared
+	// against the thresholds
+	Query string `json:"query,omitempty"`
+	// MaxValue fails the gate when the result is greater than this value
+	MaxValue string `json:"maxValue,omitempty"`
+	// MinValue fails the gate when the result is less than this value
+	MinValue string `json:"minValue,omitempty"`
+}
+
+type ExecContainerAction struct {
+	// Docker image name.
+	Image string `json:"image,omitempty" `
+
+	Command []string `json:"command,omitempty"`
+}
+
+type AuthenticationSpec struct {
+	Kopeio *KopeioAuthenticationSpec `json:"kopeio,omitempty"`
+}
+
+func (s *AuthenticationSpec) IsEmpty() bool {
+	return s.Kopeio == nil
+}
+
+type KopeioAuthenticationSpec struct {
+}
+
+type AuthorizationSpec struct {
+	AlwaysAllow *AlwaysAllowAuthorizationSpec `json:"alwaysAllow,omitempty"`
+	RBAC        *RBACAuthorizationSpec        `json:"rbac,omitempty"`
+}
+
+func (s *AuthorizationSpec) IsEmpty() bool {
+	return s.RBAC == nil && s.AlwaysAllow == nil
+}
+
+type RBACAuthorizationSpec struct {
+}
+
+type AlwaysAllowAuthorizationSpec struct {
+}
+
+type AccessSpec struct {
+	DNS          *DNSAccessSpec          `json:"dns,omitempty"`
+	LoadBalancer *LoadBalancerAccessSpec `json:"loadBalancer,omitempty"`
+
+	// SplitHorizon also publishes the public API name inside the network,
+	// resolving to the masters' private IPs; clients on a VPN reach the API
+	// directly while internet clients keep using the public endpoint
+	SplitHorizon *bool `json:"splitHorizon,omitempty"`
+}
+
+func (s *AccessSpec) IsEmpty() bool {
+	return s.DNS == nil && s.LoadBalancer == nil
+}
+
+type DNSAccessSpec struct {
+}
+
+// LoadBalancerType string describes LoadBalancer types (public, internal)
+type LoadBalancerType string
+
+const (
+	LoadBalancerTypePublic   LoadBalancerType = "Public"
+	LoadBalancerTypeInternal LoadBalancerType = "Internal"
+)
+
+type LoadBalancerAccessSpec struct {
+	Type               LoadBalancerType `json:"type,omitempty"`
+	IdleTimeoutSeconds *int64           `json:"idleTimeoutSeconds,omitempty"`
+}
+
+type KubeDNSConfig struct {
+	// Image is the name of the docker image to run
+	Image string `json:"image,omitempty"`
+
+	Replicas int    `json:"replicas,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	ServerIP string `json:"serverIP,omitempty"`
+}
+
+type EtcdClusterSpec struct {
+	// Name is the name of the etcd cluster (main, events etc)
+	Name string `json:"name,omitempty"`
+
+	// EtcdMember stores the configurations for each member of the cluster (including the data volume)
+	Members []*EtcdMemberSpec `json:"etcdMembers,omitempty"`
+}
+
+type EtcdMemberSpec struct {
+	// Name is the name of the member within the etcd cluster
+	Name          string  `json:"name,omitempty"`
+	InstanceGroup *string `json:"instanceGroup,omitempty"`
+
+	VolumeType      *string `json:"volumeType,omitempty"`
+	VolumeSize      *int32  `json:"volumeSize,omitempty"`
+	KmsKeyId        *string `json:"kmsKeyId,omitempty"`
+	EncryptedVolume *bool   `json:"encryptedVolume,omitempty"`
+}
+
+// SubnetType string describes subnet types (public, private, utility)
+type SubnetType string
+
+const (
+	SubnetTypePublic  SubnetType = "Public"
+	SubnetTypePrivate SubnetType = "Private"
+	SubnetTypeUtility SubnetType = "Utility"
+)
+
+type ClusterSubnetSpec struct {
+	Name string `json:"name,omitempty"`
+
+	Zone string `json:"zone,omitempty"`
+
+	CIDR string `json:"cidr,omitempty"`
+
+	// ProviderID is the cloud provider id for the objects associated with the zone (the subnet on AWS)
+	ProviderID string `json:"id,omitempty"`
+
+	Egress string `json:"egress,omitempty"`
+
+	Type SubnetType `json:"type,omitempty"`
+}
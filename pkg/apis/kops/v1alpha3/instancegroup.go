@@ -0,0 +1,149 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient=true
+
+// InstanceGroup represents a group of instances (either nodes or masters) with the same configuration
+type InstanceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec InstanceGroupSpec `json:"spec,omitempty"`
+}
+
+type InstanceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []InstanceGroup `json:"items"`
+}
+
+// InstanceGroupRole string describes the roles of the nodes in this InstanceGroup (master or nodes)
+type InstanceGroupRole string
+
+const (
+	InstanceGroupRoleMaster  InstanceGroupRole = "Master"
+	InstanceGroupRoleNode    InstanceGroupRole = "Node"
+	InstanceGroupRoleBastion InstanceGroupRole = "Bastion"
+)
+
+var AllInstanceGroupRoles = []InstanceGroupRole{
+	InstanceGroupRoleNode,
+	InstanceGroupRoleMaster,
+	InstanceGroupRoleBastion,
+}
+
+type InstanceGroupSpec struct {
+	// Type determines the role of instances in this group: masters or nodes
+	Role InstanceGroupRole `json:"role,omitempty"`
+
+	Image   string `json:"image,omitempty"`
+	MinSize *int32 `json:"minSize,omitempty"`
+	MaxSize *int32 `json:"maxSize,omitempty"`
+	//NodeInstancePrefix string `json:",omitempty"`
+	//NodeLabels         string `json:",omitempty"`
+	MachineType string `json:"machineType,omitempty"`
+	//NodeTag            string `json:",omitempty"`
+
+	// RootVolumeSize is the size of the EBS root volume to use, in GB
+	RootVolumeSize *int32 `json:"rootVolumeSize,omitempty"`
+	// RootVolumeType is the type of the EBS root volume to use (e.g. gp2)
+	RootVolumeType *string `json:"rootVolumeType,omitempty"`
+	// RootVolumeOptimization enables EBS optimization for an instance
+	RootVolumeOptimization *bool `json:"rootVolumeOptimization,omitempty"`
+
+	// Subnets is the names of the Subnets (as specified in the Cluster) where machines in this instance group should be placed
+	Subnets []string `json:"subnets,omitempty"`
+
+	// MaxPrice indicates this is a spot-pricing group, with the specified value as our max-price bid
+	MaxPrice *string `json:"maxPrice,omitempty"`
+
+	// AssociatePublicIP is true if we want instances to have a public IP
+	AssociatePublicIP *bool `json:"associatePublicIp,omitempty"`
+
+	// AdditionalSecurityGroups attaches additional security groups (e.g. i-123456)
+	AdditionalSecurityGroups []string `json:"additionalSecurityGroups,omitempty"`
+
+	// CloudLabels indicates the labels for instances in this group, at the AWS level
+	CloudLabels map[string]string `json:"cloudLabels,omitempty"`
+
+	// NodeLabels indicates the kubernetes labels for nodes in this group
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// Describes the tenancy of the instance group. Can be either default or dedicated.
+	// Currently only applies to AWS.
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// Kubelet overrides kubelet config from the ClusterSpec
+	Kubelet *KubeletConfigSpec `json:"kubelet,omitempty"`
+
+	// Taints indicates the kubernetes taints for nodes in this group
+	Taints []string `json:"taints,omitempty"`
+
+	// CloudLabelsPolicy overrides the cluster-level CloudLabelsPolicy for this group
+	CloudLabelsPolicy *CloudLabelsPolicySpec `json:"cloudLabelsPolicy,omitempty"`
+
+	// ScheduledActions are scheduled changes to the size of this group (e.g. a nightly scale-down)
+	ScheduledActions []ScheduledActionSpec `json:"scheduledActions,omitempty"`
+
+	// ExpiresAt, if set, marks this group as temporary; after this time the group
+	// is deleted by kops update cluster
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// PrePullImages are docker images to pull on the node at startup, before
+	// workloads land on it
+	PrePullImages []string `json:"prePullImages,omitempty"`
+	// MinHealthyPercent is the percentage of this group's expected nodes that must be
+	// Ready for validation to pass (default 100).  Lower values keep validation stable
+	// for large autoscaled groups that fluctuate by an instance or two.
+	MinHealthyPercent *int32 `json:"minHealthyPercent,omitempty"`
+	// MaxInstanceLifetime, if set, marks instances older than the limit for
+	// replacement on the next rolling update, keeping the fleet fresh without
+	// manual rolls
+	MaxInstanceLifetime *metav1.Duration `json:"maxInstanceLifetime,omitempty"`
+	// RollingUpdate configures how rolling updates replace the instances in
+	// this group
+	RollingUpdate *RollingUpdateSpec `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateSpec configures how a rolling update replaces the instances of
+// an InstanceGroup
+type RollingUpdateSpec struct {
+	// MaxSurge is the number of extra instances to create before old instances
+	// are terminated, so the group keeps its capacity during the update.
+	// Surging is only supported on clouds that can detach instances from their
+	// scaling group; elsewhere instances are replaced in place.
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+}
+
+// ScheduledActionSpec describes a scheduled change to the size of an InstanceGroup,
+// applied as an autoscaling group scheduled action by the cloud
+type ScheduledActionSpec struct {
+	// Schedule is a cron expression (in UTC) describing when the action runs
+	Schedule string `json:"schedule,omitempty"`
+	// MinSize is the minimum size to apply, if set
+	MinSize *int32 `json:"minSize,omitempty"`
+	// MaxSize is the maximum size to apply, if set
+	MaxSize *int32 `json:"maxSize,omitempty"`
+	// DesiredCapacity is the size to scale to at the scheduled time, if set
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
+}
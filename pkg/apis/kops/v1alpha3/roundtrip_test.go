@@ -0,0 +1,72 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	apitesting "k8s.io/apimachinery/pkg/api/testing"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/kops/pkg/apis/kops"
+	_ "k8s.io/kops/pkg/apis/kops/install"
+	"k8s.io/kops/pkg/apis/kops/v1alpha3"
+)
+
+// Round-trip fuzz testing: an internal object converted to v1alpha3 and back must
+// come out unchanged, so storing specs in v1alpha3 is lossless.  Fields that
+// diverge from the internal types must grow manual conversions (and an entry in
+// CHANGES.md) before they can break this.
+
+const fuzzIters = 50
+
+func roundTrip(t *testing.T, internal runtime.Object, external runtime.Object) {
+	fuzzer := apitesting.FuzzerFor(apitesting.GenericFuzzerFuncs(t, kops.Codecs), rand.NewSource(152))
+
+	for i := 0; i < fuzzIters; i++ {
+		original := reflect.New(reflect.TypeOf(internal).Elem()).Interface().(runtime.Object)
+		fuzzer.Fuzz(original)
+
+		converted := reflect.New(reflect.TypeOf(external).Elem()).Interface().(runtime.Object)
+		if err := kops.Scheme.Convert(original, converted, nil); err != nil {
+			t.Fatalf("error converting %T to v1alpha3: %v", original, err)
+		}
+
+		result := reflect.New(reflect.TypeOf(internal).Elem()).Interface().(runtime.Object)
+		if err := kops.Scheme.Convert(converted, result, nil); err != nil {
+			t.Fatalf("error converting %T from v1alpha3: %v", converted, err)
+		}
+
+		if !reflect.DeepEqual(original, result) {
+			t.Fatalf("%T changed during round-trip: %s", original, diff.ObjectGoPrintSideBySide(original, result))
+		}
+	}
+}
+
+func TestRoundTripCluster(t *testing.T) {
+	roundTrip(t, &kops.Cluster{}, &v1alpha3.Cluster{})
+}
+
+func TestRoundTripInstanceGroup(t *testing.T) {
+	roundTrip(t, &kops.InstanceGroup{}, &v1alpha3.InstanceGroup{})
+}
+
+func TestRoundTripFederation(t *testing.T) {
+	roundTrip(t, &kops.Federation{}, &v1alpha3.Federation{})
+}
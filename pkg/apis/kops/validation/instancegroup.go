@@ -18,6 +18,8 @@ package validation
 
 import (
 	"fmt"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/util"
@@ -53,9 +55,68 @@ func ValidateInstanceGroup(g *kops.InstanceGroup) error {
 		}
 	}
 
+	for k := range g.Spec.CloudLabels {
+		if isReservedCloudLabel(k) {
+			return field.Forbidden(field.NewPath("Spec", "CloudLabels"), fmt.Sprintf("%q is reserved for use by kops", k))
+		}
+	}
+
+	if g.Spec.ExpiresAt != nil && g.IsMaster() {
+		return field.Forbidden(field.NewPath("Spec", "ExpiresAt"), "Master instance groups cannot expire")
+	}
+
+	if g.Spec.MinHealthyPercent != nil {
+		percent := *g.Spec.MinHealthyPercent
+		if percent < 1 || percent > 100 {
+			return field.Invalid(field.NewPath("Spec", "MinHealthyPercent"), percent, "MinHealthyPercent must be between 1 and 100")
+		}
+	}
+
+	if g.Spec.MaxInstanceLifetime != nil && g.Spec.MaxInstanceLifetime.Duration <= 0 {
+		return field.Invalid(field.NewPath("Spec", "MaxInstanceLifetime"), g.Spec.MaxInstanceLifetime.Duration.String(), "MaxInstanceLifetime must be positive")
+	}
+
+	if g.Spec.RollingUpdate != nil && g.Spec.RollingUpdate.MaxSurge != nil {
+		maxSurge := *g.Spec.RollingUpdate.MaxSurge
+		if maxSurge < 0 {
+			return field.Invalid(field.NewPath("Spec", "RollingUpdate", "MaxSurge"), maxSurge, "MaxSurge cannot be negative")
+		}
+		if maxSurge > 0 && g.IsMaster() {
+			return field.Forbidden(field.NewPath("Spec", "RollingUpdate", "MaxSurge"), "Surge updates are not supported for master instance groups")
+		}
+	}
+
+	for i, action := range g.Spec.ScheduledActions {
+		fieldPath := field.NewPath("Spec", "ScheduledActions").Index(i)
+		if action.Schedule == "" {
+			return field.Required(fieldPath.Child("Schedule"), "Schedule must be set")
+		}
+		if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+			return field.Required(fieldPath, "At least one of MinSize, MaxSize or DesiredCapacity must be set")
+		}
+	}
+
 	return nil
 }
 
+// reservedCloudLabelPrefixes are the tag prefixes kops itself relies on; user-specified
+// cloudLabels are not allowed to override them
+var reservedCloudLabelPrefixes = []string{
+	"k8s.io/role/",
+	"k8s.io/etcd/",
+	"kubernetes.io/cluster/",
+	"KubernetesCluster",
+}
+
+func isReservedCloudLabel(label string) bool {
+	for _, prefix := range reservedCloudLabelPrefixes {
+		if strings.HasPrefix(label, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // CrossValidate performs validation of the instance group, including that it is consistent with the Cluster
 // It calls Validate, so all that validation is included.
 func CrossValidateInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster, strict bool) error {
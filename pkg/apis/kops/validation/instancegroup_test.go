@@ -63,3 +63,35 @@ func TestDefaultTaintsEnforcedBefore160(t *testing.T) {
 		}
 	}
 }
+
+func TestReservedCloudLabelsRejected(t *testing.T) {
+	grid := []struct {
+		label     string
+		shouldErr bool
+	}{
+		{"k8s.io/role/node", true},
+		{"k8s.io/etcd/main", true},
+		{"kubernetes.io/cluster/test.example.com", true},
+		{"KubernetesCluster", true},
+		{"team", false},
+		{"k8s.io/cluster-autoscaler/enabled", false},
+	}
+
+	for _, g := range grid {
+		ig := &kops.InstanceGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: kops.InstanceGroupSpec{
+				Role:        kops.InstanceGroupRoleNode,
+				CloudLabels: map[string]string{g.label: "1"},
+			},
+		}
+
+		err := ValidateInstanceGroup(ig)
+		if g.shouldErr && err == nil {
+			t.Errorf("expected cloud label %q to be rejected", g.label)
+		}
+		if !g.shouldErr && err != nil {
+			t.Errorf("unexpected error for cloud label %q: %v", g.label, err)
+		}
+	}
+}
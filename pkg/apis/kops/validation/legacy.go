@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/util"
+	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/upup/pkg/fi"
 	"net"
 	"strings"
@@ -76,6 +77,16 @@ func ValidateCluster(c *kops.Cluster, strict bool) error {
 		return fmt.Errorf("must configure at least one Subnet (use --zones)")
 	}
 
+	for _, flag := range c.Spec.FeatureGates {
+		key := flag
+		if key != "" && (key[0] == '+' || key[0] == '-') {
+			key = key[1:]
+		}
+		if key == "" || !featureflag.IsKnown(key) {
+			return field.Invalid(specField.Child("FeatureGates"), flag, "unknown feature flag")
+		}
+	}
+
 	if strict && c.Spec.Kubelet == nil {
 		return fmt.Errorf("Kubelet not configured")
 	}
@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kops/pkg/apis/kops"
 	"net"
+	"strconv"
 	"strings"
 )
 
@@ -59,6 +60,31 @@ func validateClusterSpec(spec *kops.ClusterSpec, fieldPath *field.Path) field.Er
 		allErrs = append(allErrs, validateHook(&spec.Hooks[i], fieldPath.Child("hooks").Index(i))...)
 	}
 
+	for i := range spec.ComponentPatches {
+		allErrs = append(allErrs, validateComponentPatch(&spec.ComponentPatches[i], fieldPath.Child("componentPatches").Index(i))...)
+	}
+
+	if spec.Adopt != nil {
+		allErrs = append(allErrs, validateAdopt(spec.Adopt, fieldPath.Child("adopt"))...)
+	}
+
+	for i := range spec.RollingUpdateVerification {
+		allErrs = append(allErrs, validateVerificationCheck(&spec.RollingUpdateVerification[i], fieldPath.Child("rollingUpdateVerification").Index(i))...)
+	}
+
+	if spec.Validation != nil {
+		for i := range spec.Validation.MetricGates {
+			allErrs = append(allErrs, validateMetricGate(&spec.Validation.MetricGates[i], fieldPath.Child("validation", "metricGates").Index(i))...)
+		}
+	}
+
+	// CloudLabels must not shadow the tags kops itself manages
+	for k := range spec.CloudLabels {
+		if isReservedCloudLabel(k) {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("cloudLabels").Key(k), fmt.Sprintf("%q is reserved for use by kops", k)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -131,6 +157,99 @@ func validateSubnet(subnet *kops.ClusterSubnetSpec, fieldPath *field.Path) field
 	return allErrs
 }
 
+func validateAdopt(v *kops.AdoptSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if v.MastersSecurityGroup != "" && !strings.HasPrefix(v.MastersSecurityGroup, "sg-") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mastersSecurityGroup"), v.MastersSecurityGroup, "security groups are adopted by id (sg-...)"))
+	}
+	if v.NodesSecurityGroup != "" && !strings.HasPrefix(v.NodesSecurityGroup, "sg-") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodesSecurityGroup"), v.NodesSecurityGroup, "security groups are adopted by id (sg-...)"))
+	}
+	if len(v.APILoadBalancer) > 32 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("apiLoadBalancer"), v.APILoadBalancer, "load balancer names are limited to 32 characters"))
+	}
+
+	return allErrs
+}
+
+func validateVerificationCheck(v *kops.VerificationCheckSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if v.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+
+	switch v.Type {
+	case "http":
+		if v.URL == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("url"), "http checks require a url"))
+		}
+	case "job":
+		if v.Image == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("image"), "job checks require an image"))
+		}
+	case "prometheus":
+		if v.PrometheusURL == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("prometheusURL"), "prometheus checks require a prometheusURL"))
+		}
+		if v.Query == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("query"), "prometheus checks require a query"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), v.Type, []string{"http", "job", "prometheus"}))
+	}
+
+	if v.TimeoutSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSeconds"), v.TimeoutSeconds, "timeoutSeconds cannot be negative"))
+	}
+
+	return allErrs
+}
+
+func validateMetricGate(v *kops.MetricGateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if v.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	if v.PrometheusURL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("prometheusURL"), ""))
+	}
+	if v.Query == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("query"), ""))
+	}
+
+	if v.MaxValue == "" && v.MinValue == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("maxValue"), "a metric gate needs a maxValue or a minValue"))
+	}
+	if v.MaxValue != "" {
+		if _, err := strconv.ParseFloat(v.MaxValue, 64); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxValue"), v.MaxValue, "not a number"))
+		}
+	}
+	if v.MinValue != "" {
+		if _, err := strconv.ParseFloat(v.MinValue, 64); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minValue"), v.MinValue, "not a number"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateComponentPatch(v *kops.ComponentPatchSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if v.Component == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("Component"), ""))
+	}
+
+	if v.Patch == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("Patch"), ""))
+	}
+	return allErrs
+}
+
 func validateHook(v *kops.HookSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
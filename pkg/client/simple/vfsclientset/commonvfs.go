@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kops "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/v1alpha2"
+	"k8s.io/kops/pkg/readonly"
 	"k8s.io/kops/util/pkg/vfs"
 	"os"
 	"reflect"
@@ -80,6 +81,10 @@ func (c *commonVFS) create(i runtime.Object) error {
 		return err
 	}
 
+	if readonly.Enabled() {
+		return readonly.Error(fmt.Sprintf("create %s %q", c.kind, objectMeta.GetName()))
+	}
+
 	if c.validate != nil {
 		err = c.validate(i)
 		if err != nil {
@@ -174,6 +179,10 @@ func (c *commonVFS) update(i runtime.Object) error {
 		return err
 	}
 
+	if readonly.Enabled() {
+		return readonly.Error(fmt.Sprintf("update %s %q", c.kind, objectMeta.GetName()))
+	}
+
 	if c.validate != nil {
 		err = c.validate(i)
 		if err != nil {
@@ -195,6 +204,10 @@ func (c *commonVFS) update(i runtime.Object) error {
 }
 
 func (c *commonVFS) delete(name string, options *metav1.DeleteOptions) error {
+	if readonly.Enabled() {
+		return readonly.Error(fmt.Sprintf("delete %s %q", c.kind, name))
+	}
+
 	p := c.basePath.Join(name)
 	err := p.Remove()
 	if err != nil {
@@ -0,0 +1,134 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deprecation is the central registry of deprecated spec fields, so
+// that every command warns about them consistently instead of each command
+// growing its own checks.
+package deprecation
+
+import (
+	"fmt"
+
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// Warning records a use of a deprecated field in a spec
+type Warning struct {
+	// Kind is the kind of the object using the field (Cluster or InstanceGroup)
+	Kind string
+	// Name is the name of the object using the field
+	Name string
+	// Field is the path of the deprecated field (e.g. "spec.kubelet.configureCbr0")
+	Field string
+	// RemoveInVersion is the kops version in which the field stops working
+	RemoveInVersion string
+	// Replacement is the field or mechanism that replaces it, if any
+	Replacement string
+}
+
+// String formats the warning as a single human-readable line
+func (w *Warning) String() string {
+	s := fmt.Sprintf("%s %q uses deprecated field %s (will be removed in kops %s", w.Kind, w.Name, w.Field, w.RemoveInVersion)
+	if w.Replacement != "" {
+		s += fmt.Sprintf("; use %s instead", w.Replacement)
+	}
+	return s + ")"
+}
+
+// clusterRule describes one deprecated cluster field
+type clusterRule struct {
+	field           string
+	removeInVersion string
+	replacement     string
+	applies         func(spec *kops.ClusterSpec) bool
+}
+
+var clusterRules = []clusterRule{
+	{
+		field:           "spec.kubelet.configureCbr0",
+		removeInVersion: "1.8",
+		replacement:     "spec.networking",
+		applies: func(spec *kops.ClusterSpec) bool {
+			return spec.Kubelet != nil && spec.Kubelet.ConfigureCBR0 != nil
+		},
+	},
+	{
+		field:           "spec.kubelet.reconcileCIDR",
+		removeInVersion: "1.8",
+		replacement:     "",
+		applies: func(spec *kops.ClusterSpec) bool {
+			return spec.Kubelet != nil && spec.Kubelet.ReconcileCIDR != nil
+		},
+	},
+	{
+		field:           "spec.kubelet.apiServers",
+		removeInVersion: "1.8",
+		replacement:     "spec.kubelet.kubeconfigPath",
+		applies: func(spec *kops.ClusterSpec) bool {
+			return spec.Kubelet != nil && spec.Kubelet.APIServers != ""
+		},
+	},
+	{
+		field:           "spec.kubelet.babysitDaemons",
+		removeInVersion: "1.8",
+		replacement:     "",
+		applies: func(spec *kops.ClusterSpec) bool {
+			return spec.Kubelet != nil && spec.Kubelet.BabysitDaemons != nil
+		},
+	},
+}
+
+// FindClusterDeprecations returns warnings for all deprecated fields set on the cluster
+func FindClusterDeprecations(cluster *kops.Cluster) []*Warning {
+	var warnings []*Warning
+	for i := range clusterRules {
+		rule := &clusterRules[i]
+		if rule.applies(&cluster.Spec) {
+			warnings = append(warnings, &Warning{
+				Kind:            "Cluster",
+				Name:            cluster.ObjectMeta.Name,
+				Field:           rule.field,
+				RemoveInVersion: rule.removeInVersion,
+				Replacement:     rule.replacement,
+			})
+		}
+	}
+	return warnings
+}
+
+// FindInstanceGroupDeprecations returns warnings for all deprecated fields set on the instance group
+func FindInstanceGroupDeprecations(ig *kops.InstanceGroup) []*Warning {
+	var warnings []*Warning
+	if ig.Spec.Kubelet != nil && ig.Spec.Kubelet.ConfigureCBR0 != nil {
+		warnings = append(warnings, &Warning{
+			Kind:            "InstanceGroup",
+			Name:            ig.ObjectMeta.Name,
+			Field:           "spec.kubelet.configureCbr0",
+			RemoveInVersion: "1.8",
+			Replacement:     "spec.networking (on the cluster)",
+		})
+	}
+	return warnings
+}
+
+// FindDeprecations returns warnings for the cluster and all its instance groups
+func FindDeprecations(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) []*Warning {
+	warnings := FindClusterDeprecations(cluster)
+	for _, ig := range instanceGroups {
+		warnings = append(warnings, FindInstanceGroupDeprecations(ig)...)
+	}
+	return warnings
+}
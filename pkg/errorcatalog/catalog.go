@@ -0,0 +1,112 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errorcatalog maps well-known failure modes to stable error codes
+// with remediation hints, so every command explains common problems the same
+// way instead of surfacing a raw cloud error.
+package errorcatalog
+
+import (
+	"strings"
+)
+
+// Entry describes one well-known failure mode
+type Entry struct {
+	// Code is a stable identifier for the failure mode, safe for automation to branch on
+	Code string
+	// Summary is a one-line description of what went wrong
+	Summary string
+	// Remediation tells the user what to do about it
+	Remediation string
+	// DocsLink points to the relevant documentation, if any
+	DocsLink string
+
+	// substrings: the entry matches if the error text contains all of them
+	substrings []string
+}
+
+// The catalog is ordered: the first matching entry wins, so put more specific
+// matches before more general ones
+var entries = []*Entry{
+	{
+		Code:        "STATE_STORE_ACCESS_DENIED",
+		Summary:     "kops was denied access to the state store",
+		Remediation: "Check that your credentials have s3:GetObject, s3:PutObject and s3:ListBucket on the state store bucket, and that KOPS_STATE_STORE points at the right bucket.",
+		DocsLink:    "https://github.com/kubernetes/kops/blob/master/docs/aws.md",
+		substrings:  []string{"AccessDenied"},
+	},
+	{
+		Code:        "CLOUD_CREDENTIALS_EXPIRED",
+		Summary:     "your cloud credentials have expired",
+		Remediation: "Refresh your credentials (e.g. re-run your federation/assume-role tooling) and retry.",
+		substrings:  []string{"ExpiredToken"},
+	},
+	{
+		Code:        "CLOUD_CREDENTIALS_MISSING",
+		Summary:     "no cloud credentials were found",
+		Remediation: "Configure credentials (e.g. ~/.aws/credentials, AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY, or an instance profile) and retry.",
+		DocsLink:    "https://github.com/kubernetes/kops/blob/master/docs/aws.md",
+		substrings:  []string{"NoCredentialProviders"},
+	},
+	{
+		Code:        "DNS_ZONE_NOT_FOUND",
+		Summary:     "the DNS hosted zone for the cluster domain was not found",
+		Remediation: "Create a hosted zone for the cluster domain (or a parent domain) and make sure NS delegation from the parent zone is in place; dig NS <domain> should return the zone's name servers.",
+		DocsLink:    "https://github.com/kubernetes/kops/blob/master/docs/aws.md",
+		substrings:  []string{"error querying zones"},
+	},
+	{
+		Code:        "DNS_ZONE_NOT_FOUND",
+		Summary:     "the DNS hosted zone for the cluster domain was not found",
+		Remediation: "Create a hosted zone for the cluster domain (or a parent domain) and make sure NS delegation from the parent zone is in place; dig NS <domain> should return the zone's name servers.",
+		DocsLink:    "https://github.com/kubernetes/kops/blob/master/docs/aws.md",
+		substrings:  []string{"cannot find DNS Zone"},
+	},
+	{
+		Code:        "IAM_INSTANCE_PROFILE_MISSING",
+		Summary:     "an IAM instance profile used by the cluster does not exist",
+		Remediation: "Run kops update cluster --yes to recreate the missing IAM resources, or check that nothing outside kops deleted them.",
+		substrings:  []string{"InvalidParameterValue", "iamInstanceProfile"},
+	},
+	{
+		Code:        "CLOUD_RATE_LIMITED",
+		Summary:     "the cloud provider is rate limiting API calls",
+		Remediation: "Wait a minute and retry; if this persists, reduce concurrent kops/terraform runs against this account.",
+		substrings:  []string{"RequestLimitExceeded"},
+	},
+}
+
+// Lookup returns the catalog entry matching the error, or nil if the error is
+// not a well-known failure mode
+func Lookup(err error) *Entry {
+	if err == nil {
+		return nil
+	}
+	text := err.Error()
+	for _, entry := range entries {
+		matched := true
+		for _, substring := range entry.substrings {
+			if !strings.Contains(text, substring) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return entry
+		}
+	}
+	return nil
+}
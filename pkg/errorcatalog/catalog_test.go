@@ -0,0 +1,48 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorcatalog
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	grid := []struct {
+		err  error
+		code string
+	}{
+		{fmt.Errorf("error reading s3://bucket/cluster: AccessDenied: Access Denied"), "STATE_STORE_ACCESS_DENIED"},
+		{fmt.Errorf("ExpiredToken: The security token included in the request is expired"), "CLOUD_CREDENTIALS_EXPIRED"},
+		{fmt.Errorf("NoCredentialProviders: no valid providers in chain"), "CLOUD_CREDENTIALS_MISSING"},
+		{fmt.Errorf("error querying zones: timed out"), "DNS_ZONE_NOT_FOUND"},
+		{fmt.Errorf("RequestLimitExceeded: Request limit exceeded"), "CLOUD_RATE_LIMITED"},
+		{fmt.Errorf("some other error"), ""},
+		{nil, ""},
+	}
+
+	for _, g := range grid {
+		entry := Lookup(g.err)
+		code := ""
+		if entry != nil {
+			code = entry.Code
+		}
+		if code != g.code {
+			t.Errorf("Lookup(%v): expected code %q, got %q", g.err, g.code, code)
+		}
+	}
+}
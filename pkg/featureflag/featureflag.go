@@ -25,6 +25,7 @@ limitations under the License.
 package featureflag
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"sync"
@@ -97,6 +98,53 @@ func ParseFlags(f string) {
 	}
 }
 
+// ParseSpecFlags applies feature flags from the cluster spec.  Unlike
+// ParseFlags it rejects flags that have not been declared, so a typo in the
+// spec is an error rather than a silent no-op, and it never overrides a flag
+// already set from the KOPS_FEATURE_FLAGS environment variable.
+func ParseSpecFlags(specFlags []string) error {
+	for _, s := range specFlags {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		enabled := true
+		key := s
+		if s[0] == '+' || s[0] == '-' {
+			key = s[1:]
+			if s[0] == '-' {
+				enabled = false
+			}
+		}
+
+		flagsMutex.Lock()
+		ff := flags[key]
+		flagsMutex.Unlock()
+
+		if ff == nil {
+			return fmt.Errorf("unknown feature flag %q in cluster spec", key)
+		}
+		if ff.enabled != nil {
+			glog.V(2).Infof("FeatureFlag %q already set from KOPS_FEATURE_FLAGS; ignoring cluster spec value", key)
+			continue
+		}
+
+		glog.Infof("FeatureFlag %q=%v (from cluster spec)", key, enabled)
+		ff.enabled = &enabled
+	}
+	return nil
+}
+
+// IsKnown reports whether a feature flag with the key has been declared.
+func IsKnown(key string) bool {
+	flagsMutex.Lock()
+	defer flagsMutex.Unlock()
+
+	_, found := flags[key]
+	return found
+}
+
 func New(key string, defaultValue *bool) *FeatureFlag {
 	flagsMutex.Lock()
 	defer flagsMutex.Unlock()
@@ -42,6 +42,31 @@ func TestFlagToFalse(t *testing.T) {
 	}
 }
 
+func TestParseSpecFlags(t *testing.T) {
+	f := New("UnitTest3", Bool(false))
+
+	if err := ParseSpecFlags([]string{"+UnitTest3"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !f.Enabled() {
+		t.Fatalf("Flag was not turned on from the spec")
+	}
+
+	if err := ParseSpecFlags([]string{"NoSuchFlag"}); err == nil {
+		t.Fatalf("unknown flag in the spec was not rejected")
+	}
+
+	// A value from KOPS_FEATURE_FLAGS wins over the spec
+	ParseFlags("-UnitTest4")
+	f4 := New("UnitTest4", Bool(false))
+	if err := ParseSpecFlags([]string{"+UnitTest4"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f4.Enabled() {
+		t.Fatalf("Spec value overrode the environment value")
+	}
+}
+
 func TestSetenv(t *testing.T) {
 	f := New("UnitTest2", Bool(true))
 	if !f.Enabled() {
@@ -17,12 +17,15 @@ limitations under the License.
 package instancegroups
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/pkg/api/v1"
@@ -39,7 +42,11 @@ import (
 
 // FindCloudInstanceGroups joins data from the cloud and the instance groups into a map that can be used for updates.
 func FindCloudInstanceGroups(cloud fi.Cloud, cluster *api.Cluster, instancegroups []*api.InstanceGroup, warnUnmatched bool, nodes []v1.Node) (map[string]*CloudInstanceGroup, error) {
-	awsCloud := cloud.(awsup.AWSCloud)
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		// TODO: push instance group enumeration into fi.Cloud so vsphere & gce work too
+		return nil, fmt.Errorf("instance group enumeration is not yet implemented for cloud provider %q", cloud.ProviderID())
+	}
 
 	groups := make(map[string]*CloudInstanceGroup)
 
@@ -147,6 +154,11 @@ func buildCloudInstanceGroup(ig *api.InstanceGroup, g *autoscaling.Group, nodeMa
 
 	readyLaunchConfigurationName := aws.StringValue(g.LaunchConfigurationName)
 
+	maxLifetime := time.Duration(0)
+	if ig.Spec.MaxInstanceLifetime != nil {
+		maxLifetime = ig.Spec.MaxInstanceLifetime.Duration
+	}
+
 	for _, i := range g.Instances {
 		c := &CloudInstanceGroupInstance{ASGInstance: i}
 
@@ -155,7 +167,18 @@ func buildCloudInstanceGroup(ig *api.InstanceGroup, g *autoscaling.Group, nodeMa
 			c.Node = node
 		}
 
-		if readyLaunchConfigurationName == aws.StringValue(i.LaunchConfigurationName) {
+		// A node past the group's maximum lifetime needs replacement even if
+		// its configuration is current; node registration time stands in for
+		// the instance launch time
+		expired := false
+		if maxLifetime > 0 && node != nil {
+			if age := time.Since(node.ObjectMeta.CreationTimestamp.Time); age > maxLifetime {
+				glog.V(2).Infof("node %q age %v exceeds max instance lifetime %v", node.Name, age, maxLifetime)
+				expired = true
+			}
+		}
+
+		if readyLaunchConfigurationName == aws.StringValue(i.LaunchConfigurationName) && !expired {
 			n.Ready = append(n.Ready, c)
 		} else {
 			n.NeedUpdate = append(n.NeedUpdate, c)
@@ -193,8 +216,65 @@ func (c *CloudInstanceGroup) MaxSize() int {
 // TODO: Remove from ASG first so status is immediately updated?
 // TODO: Batch termination, like a rolling-update
 
+// instancesToUpdate returns the instances a rolling update should replace.
+// An instance's launch configuration name is its config digest: instances
+// already on the group's current launch configuration are in Ready and only
+// replaced under Force.  ChangedOnly restores the skip even under Force, so
+// instances created after the change are not needlessly replaced.
+func (n *CloudInstanceGroup) instancesToUpdate(rollingUpdateData *RollingUpdateCluster) []*CloudInstanceGroupInstance {
+	update := n.NeedUpdate
+	if rollingUpdateData != nil && rollingUpdateData.Force && !rollingUpdateData.ChangedOnly {
+		update = append(update, n.Ready...)
+	}
+	return update
+}
+
 // RollingUpdate performs a rolling update on a list of ec2 instances.
 func (n *CloudInstanceGroup) RollingUpdate(rollingUpdateData *RollingUpdateCluster, instanceGroupList *api.InstanceGroupList, isBastion bool, t time.Duration) (err error) {
+	update := n.instancesToUpdate(rollingUpdateData)
+
+	return n.rollingUpdateInstances(rollingUpdateData, instanceGroupList, isBastion, t, update)
+}
+
+// RollingUpdateZone performs a rolling update on the group's instances in a single availability zone.
+func (n *CloudInstanceGroup) RollingUpdateZone(rollingUpdateData *RollingUpdateCluster, instanceGroupList *api.InstanceGroupList, t time.Duration, zone string) error {
+	update := n.instancesToUpdate(rollingUpdateData)
+
+	var filtered []*CloudInstanceGroupInstance
+	for _, u := range update {
+		if aws.StringValue(u.ASGInstance.AvailabilityZone) == zone {
+			filtered = append(filtered, u)
+		}
+	}
+
+	return n.rollingUpdateInstances(rollingUpdateData, instanceGroupList, false, t, filtered)
+}
+
+// Zones returns the sorted availability zones of the instances that a rolling update would replace.
+func (n *CloudInstanceGroup) Zones(force bool) []string {
+	update := n.NeedUpdate
+	if force {
+		update = append(update, n.Ready...)
+	}
+
+	zones := make(map[string]bool)
+	for _, u := range update {
+		zone := aws.StringValue(u.ASGInstance.AvailabilityZone)
+		if zone != "" {
+			zones[zone] = true
+		}
+	}
+
+	var sorted []string
+	for zone := range zones {
+		sorted = append(sorted, zone)
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+func (n *CloudInstanceGroup) rollingUpdateInstances(rollingUpdateData *RollingUpdateCluster, instanceGroupList *api.InstanceGroupList, isBastion bool, t time.Duration, update []*CloudInstanceGroupInstance) (err error) {
 
 	// we should not get here, but hey I am going to check.
 	if rollingUpdateData == nil {
@@ -212,11 +292,6 @@ func (n *CloudInstanceGroup) RollingUpdate(rollingUpdateData *RollingUpdateClust
 
 	c := rollingUpdateData.Cloud.(awsup.AWSCloud)
 
-	update := n.NeedUpdate
-	if rollingUpdateData.Force {
-		update = append(update, n.Ready...)
-	}
-
 	if len(update) == 0 {
 		return nil
 	}
@@ -236,6 +311,10 @@ func (n *CloudInstanceGroup) RollingUpdate(rollingUpdateData *RollingUpdateClust
 		}
 	}
 
+	if surge := n.maxSurge(); surge > 0 && !isBastion {
+		return n.rollingUpdateInstancesWithSurge(rollingUpdateData, instanceGroupList, t, update, surge, c)
+	}
+
 	for _, u := range update {
 
 		instanceId := aws.StringValue(u.ASGInstance.InstanceId)
@@ -245,6 +324,11 @@ func (n *CloudInstanceGroup) RollingUpdate(rollingUpdateData *RollingUpdateClust
 			nodeName = u.Node.Name
 		}
 
+		if !isBastion && u.Node != nil && hasHoldAnnotation(u.Node, rollingUpdateData.HoldAnnotation) {
+			glog.Warningf("Skipping node %q: it carries the %q annotation; remove the annotation and rerun rolling-update to replace it.", nodeName, rollingUpdateData.HoldAnnotation)
+			continue
+		}
+
 		if isBastion {
 
 			if err = n.DeleteAWSInstance(u, instanceId, nodeName, c); err != nil {
@@ -308,31 +392,175 @@ func (n *CloudInstanceGroup) RollingUpdate(rollingUpdateData *RollingUpdateClust
 	return nil
 }
 
-// ValidateClusterWithRetries runs our validation methods on the K8s Cluster x times and then fails.
-func (n *CloudInstanceGroup) ValidateClusterWithRetries(rollingUpdateData *RollingUpdateCluster, instanceGroupList *api.InstanceGroupList, t time.Duration) (err error) {
+// maxSurge returns the number of extra instances a rolling update may create
+// before terminating old ones.  Only node groups surge: masters are replaced
+// in place so the etcd membership is never doubled, and bastions have nothing
+// to drain.
+func (n *CloudInstanceGroup) maxSurge() int {
+	spec := &n.InstanceGroup.Spec
+	if spec.Role != api.InstanceGroupRoleNode || spec.RollingUpdate == nil {
+		return 0
+	}
+	return int(fi.Int32Value(spec.RollingUpdate.MaxSurge))
+}
 
-	// TODO - We are going to need to improve Validate to allow for more than one node, not master
-	// TODO - going down at a time.
-	for i := 0; i <= rollingUpdateData.ValidateRetries; i++ {
+// rollingUpdateInstancesWithSurge replaces instances in batches of up to
+// maxSurge: each batch is detached from the autoscaling group (which launches
+// replacements immediately, so capacity is only ever added), the replacements
+// are given time to become ready, and only then are the old instances drained
+// and terminated.
+func (n *CloudInstanceGroup) rollingUpdateInstancesWithSurge(rollingUpdateData *RollingUpdateCluster, instanceGroupList *api.InstanceGroupList, t time.Duration, update []*CloudInstanceGroupInstance, surge int, c awsup.AWSCloud) error {
+	for len(update) > 0 {
+		batch := update
+		if len(batch) > surge {
+			batch = batch[:surge]
+		}
+		update = update[len(batch):]
 
-		if _, err = validation.ValidateCluster(rollingUpdateData.ClusterName, instanceGroupList, rollingUpdateData.K8sClient); err != nil {
-			glog.Infof("Cluster did not validate, and waiting longer: %v.", err)
-			time.Sleep(t / 2)
-		} else {
-			glog.Infof("Cluster validated.")
-			return nil
+		var detach []*CloudInstanceGroupInstance
+		for _, u := range batch {
+			if u.Node != nil && hasHoldAnnotation(u.Node, rollingUpdateData.HoldAnnotation) {
+				glog.Warningf("Skipping node %q: it carries the %q annotation; remove the annotation and rerun rolling-update to replace it.", u.Node.Name, rollingUpdateData.HoldAnnotation)
+				continue
+			}
+			detach = append(detach, u)
+		}
+		if len(detach) == 0 {
+			continue
+		}
+
+		var instanceIds []*string
+		for _, u := range detach {
+			instanceIds = append(instanceIds, u.ASGInstance.InstanceId)
 		}
 
+		glog.Infof("Detaching %d instance(s) from ASG %q to surge replacements.", len(instanceIds), n.ASGName)
+		request := &autoscaling.DetachInstancesInput{
+			AutoScalingGroupName:           n.asg.AutoScalingGroupName,
+			InstanceIds:                    instanceIds,
+			ShouldDecrementDesiredCapacity: aws.Bool(false),
+		}
+		if _, err := c.Autoscaling().DetachInstances(request); err != nil {
+			return fmt.Errorf("error detaching instances from ASG %q: %v", n.ASGName, err)
+		}
+
+		// Wait for the replacement instances to be created
+		time.Sleep(t)
+
+		if rollingUpdateData.CloudOnly {
+			glog.Warningf("Not validating cluster as cloudonly flag is set.")
+		} else if featureflag.DrainAndValidateRollingUpdate.Enabled() {
+			glog.Infof("Validating the cluster before terminating the old instances.")
+
+			if err := n.ValidateClusterWithRetries(rollingUpdateData, instanceGroupList, t); err != nil {
+				if rollingUpdateData.FailOnValidate {
+					return fmt.Errorf("error validating cluster after surging replacement instances: %v", err)
+				}
+				glog.Warningf("Cluster validation failed after surging replacement instances, proceeding since fail-on-validate is set to false: %v", err)
+			}
+		}
+
+		for _, u := range detach {
+			instanceId := aws.StringValue(u.ASGInstance.InstanceId)
+
+			nodeName := ""
+			if u.Node != nil {
+				nodeName = u.Node.Name
+			}
+
+			if rollingUpdateData.CloudOnly {
+				glog.Warningf("Not draining cluster nodes as 'cloudonly' flag is set.")
+			} else if featureflag.DrainAndValidateRollingUpdate.Enabled() {
+				if u.Node != nil {
+					glog.Infof("Draining the node: %q.", nodeName)
+
+					if err := n.DrainNode(u, rollingUpdateData); err != nil {
+						if rollingUpdateData.FailOnDrainError {
+							return fmt.Errorf("Failed to drain node %q: %v", nodeName, err)
+						}
+						glog.Infof("Ignoring error draining node %q: %v", nodeName, err)
+					}
+				} else {
+					glog.Warningf("Skipping drain of instance %q, because it is not registered in kubernetes", instanceId)
+				}
+			}
+
+			if err := n.TerminateDetachedInstance(u, instanceId, nodeName, c); err != nil {
+				glog.Errorf("Error terminating detached instance %q, node %q: %v", instanceId, nodeName, err)
+				return err
+			}
+		}
 	}
 
-	// for loop is done, and did not end when the cluster validated
-	return fmt.Errorf("cluster validation failed: %v", err)
+	return nil
+}
+
+// TerminateDetachedInstance terminates an EC2 instance that has been detached
+// from its autoscaling group, so the group does not launch another
+// replacement for it.
+func (n *CloudInstanceGroup) TerminateDetachedInstance(u *CloudInstanceGroupInstance, instanceId string, nodeName string, c awsup.AWSCloud) error {
+	if nodeName != "" {
+		glog.Infof("Stopping detached instance %q, node %q.", instanceId, nodeName)
+	} else {
+		glog.Infof("Stopping detached instance %q.", instanceId)
+	}
+
+	request := &ec2.TerminateInstancesInput{
+		InstanceIds: []*string{u.ASGInstance.InstanceId},
+	}
+
+	if _, err := c.EC2().TerminateInstances(request); err != nil {
+		if nodeName != "" {
+			return fmt.Errorf("error terminating instance %q, node %q: %v", instanceId, nodeName, err)
+		}
+		return fmt.Errorf("error terminating instance %q: %v", instanceId, err)
+	}
+
+	return nil
+}
+
+// hasHoldAnnotation is true if the operator has pinned the node against disruption.
+func hasHoldAnnotation(node *v1.Node, annotation string) bool {
+	if annotation == "" {
+		return false
+	}
+	value, found := node.ObjectMeta.Annotations[annotation]
+	if !found {
+		return false
+	}
+	// Annotating with "false" is the same as not annotating at all
+	return value != "false"
+}
+
+// ValidateClusterWithRetries polls validation until the cluster passes or the
+// retry budget is spent, retrying every t/2.
+func (n *CloudInstanceGroup) ValidateClusterWithRetries(rollingUpdateData *RollingUpdateCluster, instanceGroupList *api.InstanceGroupList, t time.Duration) error {
+
+	// TODO - We are going to need to improve Validate to allow for more than one node, not master
+	// TODO - going down at a time.
+	interval := t / 2
+	deadline := time.Duration(rollingUpdateData.ValidateRetries+1) * interval
+	ctx, cancel := context.WithTimeout(context.Background(), deadline)
+	defer cancel()
+
+	validator := validation.NewClusterValidator(rollingUpdateData.ClusterName, instanceGroupList, rollingUpdateData.K8sClient, nil)
+	if _, err := validator.ValidateWithDeadline(ctx, interval, 1); err != nil {
+		return fmt.Errorf("cluster validation failed: %v", err)
+	}
+
+	glog.Infof("Cluster validated.")
+	return nil
 }
 
 // ValidateCluster runs our validation methods on the K8s Cluster.
 func (n *CloudInstanceGroup) ValidateCluster(rollingUpdateData *RollingUpdateCluster, instanceGroupList *api.InstanceGroupList) error {
 
-	if _, err := validation.ValidateCluster(rollingUpdateData.ClusterName, instanceGroupList, rollingUpdateData.K8sClient); err != nil {
+	// Groups rolling concurrently share one validation at a time
+	rollingUpdateData.validationMutex.Lock()
+	defer rollingUpdateData.validationMutex.Unlock()
+
+	validator := validation.NewClusterValidator(rollingUpdateData.ClusterName, instanceGroupList, rollingUpdateData.K8sClient, nil)
+	if _, err := validator.Validate(); err != nil {
 		return fmt.Errorf("cluster %q did not pass validation: %v", rollingUpdateData.ClusterName, err)
 	}
 
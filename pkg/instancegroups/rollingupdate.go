@@ -18,6 +18,7 @@ package instancegroups
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -38,9 +39,30 @@ type RollingUpdateCluster struct {
 
 	Force bool
 
+	// ByZone rolls nodes zone by zone, completing one availability zone
+	// before starting the next, to limit blast radius for zone-affine workloads.
+	ByZone bool
+
+	// MaxConcurrentGroups is the number of node instance groups to roll at the
+	// same time; the default of 1 keeps the historical serial behavior.
+	// Ignored when ByZone is set, which implies its own ordering.
+	MaxConcurrentGroups int
+
+	// validationMutex serializes cluster validation when several groups roll
+	// concurrently, so they gate on a single shared view of cluster health
+	validationMutex sync.Mutex
+
 	K8sClient        kubernetes.Interface
 	ClientConfig     clientcmd.ClientConfig
 	FailOnDrainError bool
+
+	// HoldAnnotation is a node annotation that excludes the node from the
+	// rolling update; held nodes are skipped with a warning
+	HoldAnnotation string
+
+	// ChangedOnly skips instances that already run the group's current
+	// configuration, even under Force
+	ChangedOnly bool
 	FailOnValidate   bool
 	CloudOnly        bool
 	ClusterName      string
@@ -151,14 +173,40 @@ func (c *RollingUpdateCluster) RollingUpdate(groups map[string]*CloudInstanceGro
 
 			defer wg.Done()
 
-			for k, group := range nodeGroups {
-				err := group.RollingUpdate(c, instanceGroups, false, c.NodeInterval)
+			if c.ByZone {
+				groupResults := c.rollingUpdateNodesByZone(nodeGroups, instanceGroups)
 
 				resultsMutex.Lock()
-				results[k] = err
+				for k, err := range groupResults {
+					results[k] = err
+				}
 				resultsMutex.Unlock()
-
-				// TODO: Bail on error?
+			} else {
+				maxConcurrent := c.MaxConcurrentGroups
+				if maxConcurrent < 1 {
+					maxConcurrent = 1
+				}
+				semaphore := make(chan struct{}, maxConcurrent)
+
+				var groupWg sync.WaitGroup
+				for k, group := range nodeGroups {
+					groupWg.Add(1)
+					semaphore <- struct{}{}
+
+					go func(k string, group *CloudInstanceGroup) {
+						defer groupWg.Done()
+						defer func() { <-semaphore }()
+
+						err := group.RollingUpdate(c, instanceGroups, false, c.NodeInterval)
+
+						resultsMutex.Lock()
+						results[k] = err
+						resultsMutex.Unlock()
+
+						// TODO: Bail on error?
+					}(k, group)
+				}
+				groupWg.Wait()
 			}
 		}()
 
@@ -174,3 +222,38 @@ func (c *RollingUpdateCluster) RollingUpdate(groups map[string]*CloudInstanceGro
 	glog.Infof("Rolling update completed!")
 	return nil
 }
+
+// rollingUpdateNodesByZone rolls the node groups one availability zone at a
+// time, so that a zone-affine workload never loses nodes in two zones at once.
+func (c *RollingUpdateCluster) rollingUpdateNodesByZone(nodeGroups map[string]*CloudInstanceGroup, instanceGroups *api.InstanceGroupList) map[string]error {
+	results := make(map[string]error)
+	for k := range nodeGroups {
+		results[k] = nil
+	}
+
+	zones := make(map[string]bool)
+	for _, group := range nodeGroups {
+		for _, zone := range group.Zones(c.Force && !c.ChangedOnly) {
+			zones[zone] = true
+		}
+	}
+	var sortedZones []string
+	for zone := range zones {
+		sortedZones = append(sortedZones, zone)
+	}
+	sort.Strings(sortedZones)
+
+	for _, zone := range sortedZones {
+		glog.Infof("Rolling update for nodes in availability zone %q.", zone)
+
+		for k, group := range nodeGroups {
+			if results[k] != nil {
+				// Don't keep rolling a group after it has failed in an earlier zone
+				continue
+			}
+			results[k] = group.RollingUpdateZone(c, instanceGroups, c.NodeInterval, zone)
+		}
+	}
+
+	return results
+}
@@ -27,7 +27,9 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/pkg/api/v1"
 	"k8s.io/kops/cloudmock/aws/mockautoscaling"
+	"k8s.io/kops/cloudmock/aws/mockec2"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 )
 
@@ -758,3 +760,108 @@ func TestRollingUpdateUnknownRole(t *testing.T) {
 		}
 	}
 }
+
+func TestRollingUpdateSurge(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+
+	mockcloud := awsup.BuildMockAWSCloud("us-east-1", "abc")
+	mockcloud.MockAutoscaling = &mockautoscaling.MockAutoscaling{}
+	mockEC2 := &mockec2.MockEC2{}
+	mockcloud.MockEC2 = mockEC2
+
+	c := &RollingUpdateCluster{
+		Cloud:           mockcloud,
+		MasterInterval:  1 * time.Millisecond,
+		NodeInterval:    1 * time.Millisecond,
+		BastionInterval: 1 * time.Millisecond,
+		Force:           false,
+		K8sClient:       k8sClient,
+	}
+
+	cloud := c.Cloud.(awsup.AWSCloud)
+	cloud.Autoscaling().CreateAutoScalingGroup(&autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String("node-1"),
+		MinSize:              aws.Int64(1),
+		MaxSize:              aws.Int64(5),
+	})
+	cloud.Autoscaling().AttachInstances(&autoscaling.AttachInstancesInput{
+		AutoScalingGroupName: aws.String("node-1"),
+		InstanceIds:          []*string{aws.String("node-1a"), aws.String("node-1b")},
+	})
+
+	asgGroups, _ := cloud.Autoscaling().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{})
+	groups := make(map[string]*CloudInstanceGroup)
+	groups["node-1"] = &CloudInstanceGroup{
+		ASGName: aws.StringValue(asgGroups.AutoScalingGroups[0].AutoScalingGroupName),
+		asg:     asgGroups.AutoScalingGroups[0],
+		InstanceGroup: &kopsapi.InstanceGroup{
+			ObjectMeta: v1meta.ObjectMeta{
+				Name: "node-1",
+			},
+			Spec: kopsapi.InstanceGroupSpec{
+				Role: kopsapi.InstanceGroupRoleNode,
+				RollingUpdate: &kopsapi.RollingUpdateSpec{
+					MaxSurge: fi.Int32(1),
+				},
+			},
+		},
+		NeedUpdate: []*CloudInstanceGroupInstance{
+			{
+				ASGInstance: &autoscaling.Instance{
+					InstanceId: aws.String("node-1a"),
+				},
+				Node: &v1.Node{},
+			},
+			{
+				ASGInstance: &autoscaling.Instance{
+					InstanceId: aws.String("node-1b"),
+				},
+				Node: &v1.Node{},
+			},
+		},
+	}
+
+	err := c.RollingUpdate(groups, &kopsapi.InstanceGroupList{})
+	if err != nil {
+		t.Errorf("Error on rolling update: %v", err)
+	}
+
+	// The old instances must be detached from the group (the group launches
+	// replacements) and terminated directly
+	asgGroups, _ = cloud.Autoscaling().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{})
+	if len(asgGroups.AutoScalingGroups[0].Instances) != 0 {
+		t.Errorf("Expected all instances detached, got %d", len(asgGroups.AutoScalingGroups[0].Instances))
+	}
+
+	if len(mockEC2.TerminatedInstanceIds) != 2 {
+		t.Errorf("Expected 2 terminated instances, got %v", mockEC2.TerminatedInstanceIds)
+	}
+}
+
+func TestHasHoldAnnotation(t *testing.T) {
+	grid := []struct {
+		annotations map[string]string
+		annotation  string
+		expected    bool
+	}{
+		{nil, "kops.k8s.io/hold", false},
+		{map[string]string{"kops.k8s.io/hold": ""}, "kops.k8s.io/hold", true},
+		{map[string]string{"kops.k8s.io/hold": "true"}, "kops.k8s.io/hold", true},
+		{map[string]string{"kops.k8s.io/hold": "false"}, "kops.k8s.io/hold", false},
+		{map[string]string{"kops.k8s.io/hold": "true"}, "", false},
+		{map[string]string{"example.com/other": "true"}, "kops.k8s.io/hold", false},
+	}
+
+	for _, g := range grid {
+		node := &v1.Node{
+			ObjectMeta: v1meta.ObjectMeta{
+				Name:        "node-1a",
+				Annotations: g.annotations,
+			},
+		}
+		actual := hasHoldAnnotation(node, g.annotation)
+		if actual != g.expected {
+			t.Errorf("hasHoldAnnotation(%v, %q): expected %v, got %v", g.annotations, g.annotation, g.expected, actual)
+		}
+	}
+}
@@ -91,6 +91,11 @@ func (b *APILoadBalancerBuilder) Build(c *fi.ModelBuilderContext) error {
 		}
 	}
 
+	var adoptedELB string
+	if b.Cluster.Spec.Adopt != nil {
+		adoptedELB = b.Cluster.Spec.Adopt.APILoadBalancer
+	}
+
 	var elb *awstasks.LoadBalancer
 	{
 		loadBalancerName := b.GetELBName32("api")
@@ -134,38 +139,61 @@ func (b *APILoadBalancerBuilder) Build(c *fi.ModelBuilderContext) error {
 			return fmt.Errorf("unknown elb Type: %q", lbSpec.Type)
 		}
 
+		if adoptedELB != "" {
+			// Use the existing load balancer as-is; whoever owns it manages
+			// its listeners, subnets and security groups
+			elb.LoadBalancerName = s(adoptedELB)
+			elb.Shared = fi.Bool(true)
+			elb.SecurityGroups = nil
+		}
+
 		c.AddTask(elb)
 	}
 
-	// Create security group for API ELB
-	{
-		t := &awstasks.SecurityGroup{
-			Name:             s(b.ELBSecurityGroupName("api")),
-			VPC:              b.LinkToVPC(),
-			Description:      s("Security group for api ELB"),
-			RemoveExtraRules: []string{"port=443"},
+	if adoptedELB == "" {
+		// Create security group for API ELB
+		{
+			t := &awstasks.SecurityGroup{
+				Name:             s(b.ELBSecurityGroupName("api")),
+				VPC:              b.LinkToVPC(),
+				Description:      s("Security group for api ELB"),
+				RemoveExtraRules: []string{"port=443"},
+			}
+			c.AddTask(t)
 		}
-		c.AddTask(t)
-	}
 
-	// Allow traffic from ELB to egress freely
-	{
-		t := &awstasks.SecurityGroupRule{
-			Name:          s("api-elb-egress"),
-			SecurityGroup: b.LinkToELBSecurityGroup("api"),
-			Egress:        fi.Bool(true),
-			CIDR:          s("0.0.0.0/0"),
+		// Allow traffic from ELB to egress freely
+		{
+			t := &awstasks.SecurityGroupRule{
+				Name:          s("api-elb-egress"),
+				SecurityGroup: b.LinkToELBSecurityGroup("api"),
+				Egress:        fi.Bool(true),
+				CIDR:          s("0.0.0.0/0"),
+			}
+			c.AddTask(t)
 		}
-		c.AddTask(t)
-	}
 
-	// Allow traffic into the ELB from KubernetesAPIAccess CIDRs
-	{
-		for _, cidr := range b.Cluster.Spec.KubernetesAPIAccess {
+		// Allow traffic into the ELB from KubernetesAPIAccess CIDRs
+		{
+			for _, cidr := range b.Cluster.Spec.KubernetesAPIAccess {
+				t := &awstasks.SecurityGroupRule{
+					Name:          s("https-api-elb-" + cidr),
+					SecurityGroup: b.LinkToELBSecurityGroup("api"),
+					CIDR:          s(cidr),
+					FromPort:      i64(443),
+					ToPort:        i64(443),
+					Protocol:      s("tcp"),
+				}
+				c.AddTask(t)
+			}
+		}
+
+		// Allow HTTPS to the master instances from the ELB
+		{
 			t := &awstasks.SecurityGroupRule{
-				Name:          s("https-api-elb-" + cidr),
-				SecurityGroup: b.LinkToELBSecurityGroup("api"),
-				CIDR:          s(cidr),
+				Name:          s("https-elb-to-master"),
+				SecurityGroup: b.LinkToSecurityGroup(kops.InstanceGroupRoleMaster),
+				SourceGroup:   b.LinkToELBSecurityGroup("api"),
 				FromPort:      i64(443),
 				ToPort:        i64(443),
 				Protocol:      s("tcp"),
@@ -174,19 +202,6 @@ func (b *APILoadBalancerBuilder) Build(c *fi.ModelBuilderContext) error {
 		}
 	}
 
-	// Allow HTTPS to the master instances from the ELB
-	{
-		t := &awstasks.SecurityGroupRule{
-			Name:          s("https-elb-to-master"),
-			SecurityGroup: b.LinkToSecurityGroup(kops.InstanceGroupRoleMaster),
-			SourceGroup:   b.LinkToELBSecurityGroup("api"),
-			FromPort:      i64(443),
-			ToPort:        i64(443),
-			Protocol:      s("tcp"),
-		}
-		c.AddTask(t)
-	}
-
 	if dns.IsGossipHostname(b.Cluster.Name) {
 		// Ensure the ELB hostname is included in the TLS certificate,
 		// if we're not going to use an alias for it
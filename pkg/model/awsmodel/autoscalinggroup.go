@@ -202,6 +202,27 @@ func (b *AutoscalingGroupModelBuilder) Build(c *fi.ModelBuilderContext) error {
 			t.Tags = tags
 
 			c.AddTask(t)
+
+			// Scheduled scaling actions (e.g. a nightly scale-down)
+			for i, action := range ig.Spec.ScheduledActions {
+				sa := &awstasks.AutoscalingScheduledAction{
+					Name:             s(fmt.Sprintf("%s-%d", name, i)),
+					AutoscalingGroup: t,
+					Recurrence:       s(action.Schedule),
+				}
+
+				if action.MinSize != nil {
+					sa.MinSize = i64(int64(*action.MinSize))
+				}
+				if action.MaxSize != nil {
+					sa.MaxSize = i64(int64(*action.MaxSize))
+				}
+				if action.DesiredCapacity != nil {
+					sa.DesiredCapacity = i64(int64(*action.DesiredCapacity))
+				}
+
+				c.AddTask(sa)
+			}
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net"
+	"strconv"
 	"strings"
 
 	"github.com/blang/semver"
@@ -35,6 +36,17 @@ import (
 
 var UseLegacyELBName = featureflag.New("UseLegacyELBName", featureflag.Bool(false))
 
+const (
+	// NodeLabelPrefix is the tag prefix the cluster autoscaler expects for node-template labels,
+	// used for scale-from-zero decisions
+	NodeLabelPrefix = "k8s.io/cluster-autoscaler/node-template/label/"
+	// NodeTaintPrefix is the tag prefix the cluster autoscaler expects for node-template taints
+	NodeTaintPrefix = "k8s.io/cluster-autoscaler/node-template/taint/"
+	// NodeResourcePrefix is the tag prefix the cluster autoscaler expects for node-template
+	// extended resources (e.g. GPUs)
+	NodeResourcePrefix = "k8s.io/cluster-autoscaler/node-template/resources/"
+)
+
 type KopsModelContext struct {
 	Cluster *kops.Cluster
 
@@ -159,13 +171,30 @@ func (m *KopsModelContext) NodeInstanceGroups() []*kops.InstanceGroup {
 	return groups
 }
 
+// isAutoscaled is true if the instance group can change size, and so may be
+// managed by the cluster autoscaler
+func isAutoscaled(ig *kops.InstanceGroup) bool {
+	if ig.Spec.MinSize == nil || ig.Spec.MaxSize == nil {
+		return false
+	}
+	return *ig.Spec.MinSize != *ig.Spec.MaxSize
+}
+
 // CloudTagsForInstanceGroup computes the tags to apply to instances in the specified InstanceGroup
 func (m *KopsModelContext) CloudTagsForInstanceGroup(ig *kops.InstanceGroup) (map[string]string, error) {
 	labels := make(map[string]string)
 
+	// The IG policy overrides the cluster policy, if set
+	policy := m.Cluster.Spec.CloudLabelsPolicy
+	if ig.Spec.CloudLabelsPolicy != nil {
+		policy = ig.Spec.CloudLabelsPolicy
+	}
+
 	// Apply any user-specified global labels first so they can be overridden by IG-specific labels
-	for k, v := range m.Cluster.Spec.CloudLabels {
-		labels[k] = v
+	if policy == nil || policy.InstanceGroups == nil || *policy.InstanceGroups {
+		for k, v := range m.Cluster.Spec.CloudLabels {
+			labels[k] = v
+		}
 	}
 
 	// Apply any user-specified labels
@@ -173,6 +202,37 @@ func (m *KopsModelContext) CloudTagsForInstanceGroup(ig *kops.InstanceGroup) (ma
 		labels[k] = v
 	}
 
+	// Apply labels for cluster autoscaler node labels
+	for k, v := range ig.Spec.NodeLabels {
+		labels[NodeLabelPrefix+k] = v
+	}
+
+	// Apply labels for cluster autoscaler node taints
+	for _, v := range ig.Spec.Taints {
+		splits := strings.SplitN(v, "=", 2)
+		if len(splits) > 1 {
+			labels[NodeTaintPrefix+splits[0]] = splits[1]
+		} else {
+			// A taint without a value, e.g. dedicated:NoSchedule; the
+			// autoscaler still needs the tag, with an empty value
+			splits = strings.SplitN(v, ":", 2)
+			if len(splits) > 1 {
+				labels[NodeTaintPrefix+splits[0]] = ":" + splits[1]
+			}
+		}
+	}
+
+	// Apply labels for cluster autoscaler extended resources.  We only advertise
+	// GPUs the kubelet is actually configured to expose; tagging from the machine
+	// type alone would promise capacity the nodes never report.
+	if ig.Spec.Kubelet != nil && ig.Spec.Kubelet.NvidiaGPUs > 0 {
+		labels[NodeResourcePrefix+"alpha.kubernetes.io/nvidia-gpu"] = strconv.FormatInt(int64(ig.Spec.Kubelet.NvidiaGPUs), 10)
+	} else if isAutoscaled(ig) {
+		if mt, err := awsup.GetMachineTypeInfo(ig.Spec.MachineType); err == nil && mt.GPU {
+			glog.Warningf("InstanceGroup %q is autoscaled and uses GPU machine type %q, but does not set spec.kubelet.nvidiaGPUs; the cluster autoscaler will not scale it from zero for GPU workloads", ig.ObjectMeta.Name, ig.Spec.MachineType)
+		}
+	}
+
 	// The system tags take priority because the cluster likely breaks without them...
 
 	if ig.Spec.Role == kops.InstanceGroupRoleMaster {
@@ -59,3 +59,58 @@ func Test_GetELBName32(t *testing.T) {
 		}
 	}
 }
+
+func Test_CloudTagsForInstanceGroup(t *testing.T) {
+	c := &KopsModelContext{
+		Cluster: &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				CloudLabels: map[string]string{
+					"cluster": "label",
+					"test":    "clusterwide",
+				},
+			},
+		},
+	}
+	ig := &kops.InstanceGroup{
+		Spec: kops.InstanceGroupSpec{
+			CloudLabels: map[string]string{
+				"test": "specific",
+			},
+			NodeLabels: map[string]string{
+				"nodeLabel": "labelValue",
+			},
+			Taints: []string{
+				"spot=true:PreferNoSchedule",
+				"dedicated:NoSchedule",
+			},
+			Kubelet: &kops.KubeletConfigSpec{
+				NvidiaGPUs: 1,
+			},
+			Role: kops.InstanceGroupRoleNode,
+		},
+	}
+
+	actual, err := c.CloudTagsForInstanceGroup(ig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"cluster": "label",
+		"test":    "specific",
+		"k8s.io/cluster-autoscaler/node-template/label/nodeLabel": "labelValue",
+		"k8s.io/cluster-autoscaler/node-template/taint/spot":      "true:PreferNoSchedule",
+		"k8s.io/cluster-autoscaler/node-template/taint/dedicated": ":NoSchedule",
+		"k8s.io/cluster-autoscaler/node-template/resources/alpha.kubernetes.io/nvidia-gpu": "1",
+		"k8s.io/role/node": "1",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("unexpected tags: expected %v, got %v", expected, actual)
+	}
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Errorf("unexpected value for tag %q: expected %q, got %q", k, v, actual[k])
+		}
+	}
+}
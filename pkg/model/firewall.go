@@ -59,6 +59,10 @@ func (b *FirewallModelBuilder) buildNodeRules(c *fi.ModelBuilderContext) error {
 			Description:      s("Security group for nodes"),
 			RemoveExtraRules: []string{"port=22"},
 		}
+		if adopted := b.AdoptedSecurityGroup(kops.InstanceGroupRoleNode); adopted != "" {
+			t.ID = s(adopted)
+			t.Shared = fi.Bool(true)
+		}
 		c.AddTask(t)
 	}
 
@@ -255,6 +259,10 @@ func (b *FirewallModelBuilder) buildMasterRules(c *fi.ModelBuilderContext) error
 				// TODO: Protocol 4 for calico
 			},
 		}
+		if adopted := b.AdoptedSecurityGroup(kops.InstanceGroupRoleMaster); adopted != "" {
+			t.ID = s(adopted)
+			t.Shared = fi.Bool(true)
+		}
 		c.AddTask(t)
 	}
 
@@ -66,6 +66,16 @@ func (b *IAMModelBuilder) Build(c *fi.ModelBuilderContext) error {
 	for _, role := range roles {
 		name := b.IAMName(role)
 
+		if adopted := b.AdoptedInstanceProfile(role); adopted != "" {
+			// Adopted instance profile: use it as-is; its roles and policies
+			// belong to whoever owns it
+			c.AddTask(&awstasks.IAMInstanceProfile{
+				Name:   s(adopted),
+				Shared: fi.Bool(true),
+			})
+			continue
+		}
+
 		var iamRole *awstasks.IAMRole
 		{
 			rolePolicy, err := b.buildAWSIAMRolePolicy()
@@ -107,9 +107,12 @@ func (b *MasterVolumeBuilder) addAWSVolume(c *fi.ModelBuilderContext, name strin
 	// The tags are how protokube knows to mount the volume and use it for etcd
 	tags := make(map[string]string)
 
-	// Apply all user defined labels on the volumes
-	for k, v := range b.Cluster.Spec.CloudLabels {
-		tags[k] = v
+	// Apply all user defined labels on the volumes, unless the policy says otherwise
+	policy := b.Cluster.Spec.CloudLabelsPolicy
+	if policy == nil || policy.Volumes == nil || *policy.Volumes {
+		for k, v := range b.Cluster.Spec.CloudLabels {
+			tags[k] = v
+		}
 	}
 
 	//tags[awsup.TagClusterName] = b.C.cluster.Name
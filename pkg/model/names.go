@@ -18,6 +18,8 @@ package model
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/golang/glog"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
@@ -113,9 +115,53 @@ func (b *KopsModelContext) IAMName(role kops.InstanceGroupRole) string {
 
 func (b *KopsModelContext) LinkToIAMInstanceProfile(ig *kops.InstanceGroup) *awstasks.IAMInstanceProfile {
 	name := b.IAMName(ig.Spec.Role)
+	if adopted := b.AdoptedInstanceProfile(ig.Spec.Role); adopted != "" {
+		name = adopted
+	}
 	return &awstasks.IAMInstanceProfile{Name: &name}
 }
 
+// AdoptedInstanceProfile returns the name of an existing IAM instance profile
+// to use for the role, or "" if kops manages the profile.  An ARN in the spec
+// is reduced to the profile name.
+func (b *KopsModelContext) AdoptedInstanceProfile(role kops.InstanceGroupRole) string {
+	adopt := b.Cluster.Spec.Adopt
+	if adopt == nil {
+		return ""
+	}
+
+	var name string
+	switch role {
+	case kops.InstanceGroupRoleMaster:
+		name = adopt.MastersInstanceProfile
+	case kops.InstanceGroupRoleNode:
+		name = adopt.NodesInstanceProfile
+	}
+
+	// arn:aws:iam::123456789012:instance-profile/myprofile -> myprofile
+	if i := strings.LastIndex(name, "/"); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// AdoptedSecurityGroup returns the id of an existing security group to use for
+// the role, or "" if kops manages the group
+func (b *KopsModelContext) AdoptedSecurityGroup(role kops.InstanceGroupRole) string {
+	adopt := b.Cluster.Spec.Adopt
+	if adopt == nil {
+		return ""
+	}
+
+	switch role {
+	case kops.InstanceGroupRoleMaster:
+		return adopt.MastersSecurityGroup
+	case kops.InstanceGroupRoleNode:
+		return adopt.NodesSecurityGroup
+	}
+	return ""
+}
+
 // SSHKeyName computes a unique SSH key name, combining the cluster name and the SSH public key fingerprint
 func (c *KopsModelContext) SSHKeyName() (string, error) {
 	fingerprint, err := awstasks.ComputeOpenSSHKeyFingerprint(string(c.SSHPublicKeys[0]))
@@ -0,0 +1,53 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package readonly implements a global read-only mode: when enabled, the cloud
+// and state store client layers reject every mutation, so get/validate/dry-run
+// commands can safely run with production credentials.
+package readonly
+
+import (
+	"fmt"
+	"os"
+)
+
+var enabled bool
+
+func init() {
+	switch os.Getenv("KOPS_READ_ONLY") {
+	case "", "0", "false":
+	// not enabled
+	default:
+		enabled = true
+	}
+}
+
+// Enable turns on read-only mode; there is deliberately no way to turn it
+// back off within a process
+func Enable() {
+	enabled = true
+}
+
+// Enabled reports whether read-only mode is active
+func Enabled() bool {
+	return enabled
+}
+
+// Error builds the error returned for a rejected mutation; action describes
+// what was refused (e.g. "update Cluster \"example.com\"")
+func Error(action string) error {
+	return fmt.Errorf("running in read-only mode; refusing to %s", action)
+}
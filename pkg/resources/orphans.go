@@ -0,0 +1,162 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/glog"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+const (
+	// OrphanTypeInstance is an EC2 instance tagged for the cluster but not in any autoscaling group
+	OrphanTypeInstance = "instance"
+	// OrphanTypeAutoscalingGroup is an autoscaling group tagged for the cluster with no matching instance group
+	OrphanTypeAutoscalingGroup = "autoscaling-group"
+)
+
+// OrphanResource describes a cloud resource that is tagged for the cluster but
+// does not belong to any recognized instance group - typically a manually
+// launched instance, or an autoscaling group left behind by a deleted instance
+// group
+type OrphanResource struct {
+	// Type is OrphanTypeInstance or OrphanTypeAutoscalingGroup
+	Type string
+	// ID identifies the resource (instance id or autoscaling group name)
+	ID string
+	// Reason explains why the resource is considered orphaned
+	Reason string
+}
+
+// FindOrphanResources returns cluster-tagged instances and autoscaling groups
+// that no instance group accounts for.  It returns nil for cloud providers
+// where we cannot (yet) enumerate instances.
+func FindOrphanResources(cloud fi.Cloud, cluster *api.Cluster, instancegroups []*api.InstanceGroup) ([]*OrphanResource, error) {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return nil, nil
+	}
+
+	modelContext := &model.KopsModelContext{Cluster: cluster}
+	expected := make(map[string]bool)
+	for _, ig := range instancegroups {
+		expected[modelContext.AutoscalingGroupName(ig)] = true
+	}
+
+	asgs, err := FindAutoscalingGroups(awsCloud, awsCloud.Tags())
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []*OrphanResource
+
+	// Instances launched by any cluster-tagged ASG are accounted for by that
+	// ASG, even if the ASG itself is orphaned
+	inASG := make(map[string]bool)
+	for _, asg := range asgs {
+		for _, member := range asg.Instances {
+			inASG[aws.StringValue(member.InstanceId)] = true
+		}
+
+		name := aws.StringValue(asg.AutoScalingGroupName)
+		if !expected[name] {
+			orphans = append(orphans, &OrphanResource{
+				Type:   OrphanTypeAutoscalingGroup,
+				ID:     name,
+				Reason: "no instance group matches this autoscaling group",
+			})
+		}
+	}
+
+	glog.V(2).Infof("Querying EC2 instances tagged for cluster %q", cluster.ObjectMeta.Name)
+	request := &ec2.DescribeInstancesInput{
+		Filters: BuildEC2Filters(awsCloud),
+	}
+	err = awsCloud.EC2().DescribeInstancesPages(request, func(p *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range p.Reservations {
+			for _, instance := range reservation.Instances {
+				id := aws.StringValue(instance.InstanceId)
+
+				if instance.State != nil {
+					switch aws.StringValue(instance.State.Name) {
+					case "terminated", "shutting-down":
+						continue
+					}
+				}
+
+				if inASG[id] {
+					continue
+				}
+
+				orphans = append(orphans, &OrphanResource{
+					Type:   OrphanTypeInstance,
+					ID:     id,
+					Reason: "tagged for the cluster but not launched by any autoscaling group",
+				})
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instances: %v", err)
+	}
+
+	return orphans, nil
+}
+
+// DeleteOrphanResource removes the orphaned resource from the cloud
+func DeleteOrphanResource(cloud fi.Cloud, orphan *OrphanResource) error {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return fmt.Errorf("orphan deletion is not implemented for cloud provider %q", cloud.ProviderID())
+	}
+
+	switch orphan.Type {
+	case OrphanTypeInstance:
+		glog.V(2).Infof("Terminating orphaned instance %q", orphan.ID)
+		request := &ec2.TerminateInstancesInput{
+			InstanceIds: []*string{&orphan.ID},
+		}
+		_, err := awsCloud.EC2().TerminateInstances(request)
+		if err != nil {
+			return fmt.Errorf("error terminating instance %q: %v", orphan.ID, err)
+		}
+		return nil
+
+	case OrphanTypeAutoscalingGroup:
+		glog.V(2).Infof("Deleting orphaned autoscaling group %q", orphan.ID)
+		request := &autoscaling.DeleteAutoScalingGroupInput{
+			AutoScalingGroupName: &orphan.ID,
+			ForceDelete:          aws.Bool(true),
+		}
+		_, err := awsCloud.Autoscaling().DeleteAutoScalingGroup(request)
+		if err != nil {
+			return fmt.Errorf("error deleting autoscaling group %q: %v", orphan.ID, err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown orphan resource type %q", orphan.Type)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// ValidationCheck is an extra cluster check run by ValidateCluster after the
+// built-in node, component and pod checks.  Implementations register
+// themselves with RegisterValidationCheck, typically from an init function,
+// so custom checks can be added without forking the validator.
+type ValidationCheck interface {
+	// Name identifies the check in failure messages
+	Name() string
+
+	// Validate inspects the cluster and returns a description of each
+	// failure it found; an error means the check itself could not run
+	Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error)
+}
+
+var validationChecksMutex sync.Mutex
+var validationChecks []ValidationCheck
+
+// RegisterValidationCheck adds a check to those run by ValidateCluster.
+func RegisterValidationCheck(check ValidationCheck) {
+	validationChecksMutex.Lock()
+	defer validationChecksMutex.Unlock()
+
+	validationChecks = append(validationChecks, check)
+}
+
+// registeredValidationChecks returns a snapshot of the registered checks,
+// omitting those that have been skipped.
+func registeredValidationChecks() []ValidationCheck {
+	validationChecksMutex.Lock()
+	defer validationChecksMutex.Unlock()
+
+	var checks []ValidationCheck
+	for _, check := range validationChecks {
+		if !skippedValidationChecks[check.Name()] {
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+// Built-in check names accepted by SkipValidationCheck, alongside the names
+// of registered ValidationChecks.
+const (
+	CheckComponents      = "components"
+	CheckPods            = "pods"
+	CheckAPIServerHealth = "apiserver-health"
+)
+
+var skippedValidationChecks = map[string]bool{}
+
+// SkipValidationCheck disables a check by name.  Environments where a check
+// can never pass (air-gapped registries, custom DNS) use this to keep the
+// rest of validation useful.
+func SkipValidationCheck(name string) {
+	validationChecksMutex.Lock()
+	defer validationChecksMutex.Unlock()
+
+	skippedValidationChecks[name] = true
+}
+
+// validationCheckSkipped reports whether a built-in check was disabled.
+func validationCheckSkipped(name string) bool {
+	validationChecksMutex.Lock()
+	defer validationChecksMutex.Unlock()
+
+	return skippedValidationChecks[name]
+}
+
+// checkWorkers bounds how many registered checks run concurrently.
+const checkWorkers = 8
+
+// runValidationChecks executes the registered checks on a bounded worker pool
+// and returns their failures, each prefixed with the check name.  Failures are
+// aggregated in registration order, so output is stable across runs.
+func runValidationChecks(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	checks := registeredValidationChecks()
+
+	checkFailures := make([][]string, len(checks))
+	errors := make([]error, len(checks))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, checkWorkers)
+	for i := range checks {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			checkFailures[i], errors[i] = checks[i].Validate(clusterName, instanceGroupList, k8sClient)
+		}(i)
+	}
+	wg.Wait()
+
+	var failures []string
+	for i, check := range checks {
+		if errors[i] != nil {
+			return nil, fmt.Errorf("validation check %q failed to run: %v", check.Name(), errors[i])
+		}
+		for _, failure := range checkFailures[i] {
+			failures = append(failures, fmt.Sprintf("%s: %s", check.Name(), failure))
+		}
+	}
+	return failures, nil
+}
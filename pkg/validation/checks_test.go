@@ -0,0 +1,93 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+type fakeValidationCheck struct {
+	name     string
+	failures []string
+	err      error
+}
+
+func (f *fakeValidationCheck) Name() string {
+	return f.name
+}
+
+func (f *fakeValidationCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	return f.failures, f.err
+}
+
+// withEmptyCheckRegistry runs fn against an empty check registry, restoring
+// the checks registered by init functions afterwards.
+func withEmptyCheckRegistry(fn func()) {
+	validationChecksMutex.Lock()
+	saved := validationChecks
+	validationChecks = nil
+	validationChecksMutex.Unlock()
+
+	defer func() {
+		validationChecksMutex.Lock()
+		validationChecks = saved
+		validationChecksMutex.Unlock()
+	}()
+
+	fn()
+}
+
+func TestRunValidationChecks(t *testing.T) {
+	withEmptyCheckRegistry(func() {
+		testRunValidationChecks(t)
+	})
+}
+
+func testRunValidationChecks(t *testing.T) {
+	RegisterValidationCheck(&fakeValidationCheck{name: "healthy"})
+	RegisterValidationCheck(&fakeValidationCheck{name: "kernel-version", failures: []string{"node-1 runs 4.1.0", "node-2 runs 4.1.0"}})
+
+	failures, err := runValidationChecks("test.example.com", &kops.InstanceGroupList{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(failures) != 2 {
+		t.Fatalf("expected 2 failures, got %v", failures)
+	}
+	if failures[0] != "kernel-version: node-1 runs 4.1.0" {
+		t.Errorf("failure not prefixed with check name: %q", failures[0])
+	}
+}
+
+func TestRunValidationChecksError(t *testing.T) {
+	withEmptyCheckRegistry(func() {
+		testRunValidationChecksError(t)
+	})
+}
+
+func testRunValidationChecksError(t *testing.T) {
+	RegisterValidationCheck(&fakeValidationCheck{name: "broken", err: fmt.Errorf("cannot reach API")})
+
+	_, err := runValidationChecks("test.example.com", &kops.InstanceGroupList{}, nil)
+	if err == nil {
+		t.Fatalf("expected error when a check cannot run")
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/glog"
+
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// DiagnoseUnjoinedNodes looks at the cloud instances behind the cluster's autoscaling
+// groups, finds running instances that have not registered as nodes, and tries to work
+// out a probable cause for each from the instance state, IAM profile and console output.
+// Only implemented for AWS; other providers return nothing.
+func DiagnoseUnjoinedNodes(cloud fi.Cloud, v *ValidationCluster) ([]string, error) {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return nil, nil
+	}
+
+	asgs, err := resources.FindAutoscalingGroups(awsCloud, awsCloud.Tags())
+	if err != nil {
+		return nil, fmt.Errorf("error listing autoscaling groups: %v", err)
+	}
+
+	var instanceIDs []*string
+	for _, asg := range asgs {
+		for _, instance := range asg.Instances {
+			instanceIDs = append(instanceIDs, instance.InstanceId)
+		}
+	}
+	if len(instanceIDs) == 0 {
+		return nil, nil
+	}
+
+	// Nodes register using the EC2 instance id as ExternalID
+	registered := make(map[string]bool)
+	if v.NodeList != nil {
+		for i := range v.NodeList.Items {
+			registered[v.NodeList.Items[i].Spec.ExternalID] = true
+		}
+	}
+
+	response, err := awsCloud.EC2().DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing instances: %v", err)
+	}
+
+	var diagnoses []string
+	for _, reservation := range response.Reservations {
+		for _, instance := range reservation.Instances {
+			id := aws.StringValue(instance.InstanceId)
+			if registered[id] {
+				continue
+			}
+
+			cause := diagnoseInstance(awsCloud, instance)
+			diagnoses = append(diagnoses, fmt.Sprintf("%s: machine has not yet joined cluster; %s", id, cause))
+		}
+	}
+	return diagnoses, nil
+}
+
+// diagnoseInstance returns a probable cause for an instance that has not joined the cluster
+func diagnoseInstance(cloud awsup.AWSCloud, instance *ec2.Instance) string {
+	state := aws.StringValue(instance.State.Name)
+	if state != "running" {
+		return fmt.Sprintf("probable cause: instance is %s", state)
+	}
+
+	if instance.IamInstanceProfile == nil {
+		return "probable cause: no IAM instance profile attached, so nodeup cannot read the cluster configuration from the state store"
+	}
+
+	if len(instance.SecurityGroups) == 0 {
+		return "probable cause: no security groups attached, so the node cannot reach the masters"
+	}
+
+	// Look for errors near the end of the console output; nodeup and cloud-init
+	// log boot-time failures there
+	output, err := cloud.EC2().GetConsoleOutput(&ec2.GetConsoleOutputInput{
+		InstanceId: instance.InstanceId,
+	})
+	if err != nil {
+		glog.V(2).Infof("error fetching console output for %s: %v", aws.StringValue(instance.InstanceId), err)
+		return "probable cause unknown (could not fetch console output)"
+	}
+	if output.Output == nil {
+		return "probable cause unknown (console output not yet available)"
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(aws.StringValue(output.Output))
+	if err != nil {
+		return "probable cause unknown (could not decode console output)"
+	}
+
+	if line := lastErrorLine(string(decoded)); line != "" {
+		return fmt.Sprintf("last error in console output: %s", line)
+	}
+	return "no obvious error in console output; check kubelet and docker logs on the instance"
+}
+
+// lastErrorLine returns the last line of the console output that looks like a
+// boot-time failure, or "" if none is found
+func lastErrorLine(console string) string {
+	lines := strings.Split(console, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		lower := strings.ToLower(line)
+		if strings.Contains(lower, "error") || strings.Contains(lower, "failed") || strings.Contains(lower, "fatal") {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// validationHistoryTimestampFormat names the history records; it sorts
+// chronologically and contains no characters that are special to any of the
+// state store backends.
+const validationHistoryTimestampFormat = "20060102T150405Z"
+
+// ValidationHistoryPath is where validation records live under the cluster's
+// state store path.
+func ValidationHistoryPath(configBase vfs.Path) vfs.Path {
+	return configBase.Join("validation", "history")
+}
+
+// WriteValidationRecord persists the validation result as a timestamped record
+// in the state store, for later post-incident analysis.  The full node objects
+// are dropped; the per-node summary arrays carry everything the history needs,
+// and keep the records small.
+func WriteValidationRecord(configBase vfs.Path, v *ValidationCluster) error {
+	record := *v
+	record.NodeList = nil
+
+	data, err := json.MarshalIndent(&record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling validation record: %v", err)
+	}
+
+	p := ValidationHistoryPath(configBase).Join(v.Timestamp.UTC().Format(validationHistoryTimestampFormat) + ".json")
+	if err := p.WriteFile(data); err != nil {
+		return fmt.Errorf("error writing validation record to %q: %v", p.Path(), err)
+	}
+
+	return nil
+}
+
+// ReadValidationHistory reads all persisted validation records for the
+// cluster, oldest first.  A cluster that has never been validated with
+// --save-history has no history, which is not an error.
+func ReadValidationHistory(configBase vfs.Path) ([]*ValidationCluster, error) {
+	files, err := ValidationHistoryPath(configBase).ReadDir()
+	if err != nil {
+		// Most backends return an error for a prefix with no objects
+		glog.V(2).Infof("no validation history found: %v", err)
+		return nil, nil
+	}
+
+	var records []*ValidationCluster
+	for _, f := range files {
+		if !strings.HasSuffix(f.Base(), ".json") {
+			continue
+		}
+
+		data, err := f.ReadFile()
+		if err != nil {
+			return nil, fmt.Errorf("error reading validation record %q: %v", f.Path(), err)
+		}
+
+		record := &ValidationCluster{}
+		if err := json.Unmarshal(data, record); err != nil {
+			return nil, fmt.Errorf("error parsing validation record %q: %v", f.Path(), err)
+		}
+		records = append(records, record)
+	}
+
+	sort.Sort(byTimestamp(records))
+
+	return records, nil
+}
+
+// byTimestamp sorts validation records oldest first
+type byTimestamp []*ValidationCluster
+
+func (a byTimestamp) Len() int      { return len(a) }
+func (a byTimestamp) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byTimestamp) Less(i, j int) bool {
+	return a[i].Timestamp.Time.Before(a[j].Timestamp.Time)
+}
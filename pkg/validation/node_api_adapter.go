@@ -68,6 +68,16 @@ func (nodeAA *NodeAPIAdapter) GetAllNodes() (nodes *v1.NodeList, err error) {
 		return nil, err
 	}
 
+	// This API generation cannot page the list, so trim the parts of each
+	// node we never look at; the image and volume lists dominate the size of
+	// a node object on busy clusters
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		node.Status.Images = nil
+		node.Status.VolumesInUse = nil
+		node.Status.VolumesAttached = nil
+	}
+
 	return nodes, nil
 }
 
@@ -0,0 +1,153 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+)
+
+// notifyTimeout bounds each webhook delivery; a slow sink must not stall
+// validation
+const notifyTimeout = 10 * time.Second
+
+// NotifyValidationFailures delivers the validation result to the target,
+// which is one of:
+//
+//	slack:<url>    post a Slack-compatible text message to the webhook url
+//	webhook:<url>  post the ValidationCluster document as JSON
+//	events         create a Kubernetes Event in the kube-system namespace
+//
+// A bare url is treated as webhook:<url>.  Delivery problems are returned as
+// errors; the caller decides whether they fail the validation.
+func NotifyValidationFailures(target string, v *ValidationCluster, k8sClient kubernetes.Interface) error {
+	switch {
+	case target == "events":
+		return createValidationEvent(v, k8sClient)
+	case strings.HasPrefix(target, "slack:"):
+		return postSlackNotification(strings.TrimPrefix(target, "slack:"), v)
+	case strings.HasPrefix(target, "webhook:"):
+		return postWebhookNotification(strings.TrimPrefix(target, "webhook:"), v)
+	case strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://"):
+		return postWebhookNotification(target, v)
+	default:
+		return fmt.Errorf("unknown notification target %q (expected events, slack:<url> or webhook:<url>)", target)
+	}
+}
+
+// CheckNotifyTarget validates the target syntax without delivering anything,
+// so a typo is reported before validation runs rather than being discovered
+// when the first failure goes unannounced.
+func CheckNotifyTarget(target string) error {
+	switch {
+	case target == "events",
+		strings.HasPrefix(target, "slack:"),
+		strings.HasPrefix(target, "webhook:"),
+		strings.HasPrefix(target, "http://"),
+		strings.HasPrefix(target, "https://"):
+		return nil
+	}
+	return fmt.Errorf("unknown notification target %q (expected events, slack:<url> or webhook:<url>)", target)
+}
+
+// summarizeFailures renders the failures as one line each, for human-facing
+// sinks
+func summarizeFailures(v *ValidationCluster) string {
+	var lines []string
+	for i := range v.Failures {
+		failure := &v.Failures[i]
+		lines = append(lines, fmt.Sprintf("[%s] %s: %s", failure.Severity, failure.Code, failure.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func postSlackNotification(url string, v *ValidationCluster) error {
+	text := fmt.Sprintf("Validation of cluster %s found %d failure(s):\n%s", v.ClusterName, len(v.Failures), summarizeFailures(v))
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+	return postNotification(url, payload)
+}
+
+func postWebhookNotification(url string, v *ValidationCluster) error {
+	// The full node objects are noise for a webhook sink
+	compact := *v
+	compact.NodeList = nil
+
+	payload, err := json.Marshal(&compact)
+	if err != nil {
+		return err
+	}
+	return postNotification(url, payload)
+}
+
+func postNotification(url string, payload []byte) error {
+	client := &http.Client{Timeout: notifyTimeout}
+	response, err := client.Post(url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("error posting to %q: %v", url, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("webhook %q returned status %s", url, response.Status)
+	}
+	return nil
+}
+
+// createValidationEvent records the failures as a Warning Event on the
+// kube-system namespace, where cluster-level alerting usually watches
+func createValidationEvent(v *ValidationCluster, k8sClient kubernetes.Interface) error {
+	if k8sClient == nil {
+		return fmt.Errorf("no cluster connection for the events notification target")
+	}
+
+	now := metav1.Now()
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("cluster-validation.%x", now.UnixNano()),
+			Namespace: metav1.NamespaceSystem,
+		},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      "Namespace",
+			Name:      metav1.NamespaceSystem,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Reason:         "ClusterValidationFailed",
+		Message:        fmt.Sprintf("Validation of cluster %s found %d failure(s):\n%s", v.ClusterName, len(v.Failures), summarizeFailures(v)),
+		Source:         v1.EventSource{Component: "kops"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Type:           v1.EventTypeWarning,
+	}
+
+	_, err := k8sClient.CoreV1().Events(metav1.NamespaceSystem).Create(event)
+	if err != nil {
+		return fmt.Errorf("error creating event: %v", err)
+	}
+	return nil
+}
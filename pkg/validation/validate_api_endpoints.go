@@ -0,0 +1,96 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// apiEndpointDialTimeout bounds each endpoint probe; an unreachable endpoint
+// should not stall the whole validation.
+const apiEndpointDialTimeout = 10 * time.Second
+
+// APIEndpointCheck probes every API endpoint the cluster spec declares, not
+// just the one the kubeconfig happens to use.  A cluster is not healthy if
+// kubectl works against the public name while in-cluster clients cannot reach
+// the internal name, or if an endpoint serves a certificate that is not valid
+// for it.  It is registered by the validate command, which knows the cluster
+// spec.
+type APIEndpointCheck struct {
+	// Endpoints are the API DNS names from the cluster spec
+	Endpoints []string
+}
+
+var _ ValidationCheck = &APIEndpointCheck{}
+
+func (c *APIEndpointCheck) Name() string {
+	return "api-endpoints"
+}
+
+func (c *APIEndpointCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	var failures []string
+	for _, endpoint := range c.Endpoints {
+		if endpoint == "" {
+			continue
+		}
+		if failure := probeAPIEndpoint(endpoint); failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+	return failures, nil
+}
+
+// probeAPIEndpoint makes a TLS connection to the endpoint and checks that the
+// served certificate is valid for the name dialed.  The cluster CA is not
+// in the system trust store, so the chain itself cannot be verified here;
+// a name mismatch is still a reliable sign of a misconfigured endpoint.
+func probeAPIEndpoint(endpoint string) string {
+	dialer := &net.Dialer{Timeout: apiEndpointDialTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", endpoint+":443", &tls.Config{
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return fmt.Sprintf("API endpoint %q is unreachable: %v", endpoint, err)
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return fmt.Sprintf("API endpoint %q did not present a certificate", endpoint)
+	}
+
+	cert := certs[0]
+	if err := cert.VerifyHostname(endpoint); err != nil {
+		return fmt.Sprintf("API endpoint %q serves a certificate that is not valid for it: %v", endpoint, err)
+	}
+
+	now := time.Now()
+	if now.After(cert.NotAfter) {
+		return fmt.Sprintf("API endpoint %q serves a certificate that expired %s", endpoint, cert.NotAfter.Format(time.RFC3339))
+	}
+	if now.Before(cert.NotBefore) {
+		return fmt.Sprintf("API endpoint %q serves a certificate that is not valid until %s", endpoint, cert.NotBefore.Format(time.RFC3339))
+	}
+
+	return ""
+}
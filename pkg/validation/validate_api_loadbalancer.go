@@ -0,0 +1,71 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// CollectAPILoadBalancerHealthFailures queries the health of the instances registered
+// with the API load balancer, catching masters that the ASG considers InService but
+// that the load balancer will not route to.  Only implemented for AWS; on other
+// providers (or when the API is not behind a load balancer) it returns nothing.
+func CollectAPILoadBalancerHealthFailures(cluster *kops.Cluster, cloud fi.Cloud) ([]string, error) {
+	if cluster.Spec.API == nil || cluster.Spec.API.LoadBalancer == nil {
+		return nil, nil
+	}
+
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return nil, nil
+	}
+
+	lb, err := awstasks.FindLoadBalancerByNameTag(awsCloud, "api."+cluster.ObjectMeta.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error finding API load balancer: %v", err)
+	}
+	if lb == nil {
+		return []string{"API load balancer not found"}, nil
+	}
+
+	response, err := awsCloud.ELB().DescribeInstanceHealth(&elb.DescribeInstanceHealthInput{
+		LoadBalancerName: lb.LoadBalancerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying API load balancer instance health: %v", err)
+	}
+
+	var failures []string
+	for _, state := range response.InstanceStates {
+		if aws.StringValue(state.State) == "InService" {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("%s (%s: %s)",
+			aws.StringValue(state.InstanceId),
+			aws.StringValue(state.State),
+			aws.StringValue(state.Description)))
+	}
+	return failures, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func init() {
+	RegisterValidationCheck(&apiserverKubeletCheck{})
+}
+
+// apiserverKubeletCheck exercises the apiserver->kubelet path by fetching a
+// byte of logs from a canary pod per instance group.  Node Ready status only
+// proves the kubelet can reach the apiserver; the reverse direction has its
+// own failure modes (security group rules, broken ssh tunnels), and without it
+// kubectl logs and kubectl exec do not work.
+type apiserverKubeletCheck struct{}
+
+var _ ValidationCheck = &apiserverKubeletCheck{}
+
+func (c *apiserverKubeletCheck) Name() string {
+	return "apiserver-kubelet"
+}
+
+func (c *apiserverKubeletCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	pods, err := k8sClient.CoreV1().Pods("kube-system").List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing kube-system pods: %v", err)
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+	nodesByName := make(map[string]*v1.Node)
+	for i := range nodes.Items {
+		nodesByName[nodes.Items[i].ObjectMeta.Name] = &nodes.Items[i]
+	}
+
+	// One canary pod per instance group; nodes that cannot be attributed to
+	// an instance group share one fallback canary, so the check stays cheap
+	// on large clusters
+	canaries := make(map[string]*v1.Pod)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != v1.PodRunning || pod.Spec.NodeName == "" {
+			continue
+		}
+
+		group := ""
+		if node := nodesByName[pod.Spec.NodeName]; node != nil {
+			group = instanceGroupNameForNode(node, instanceGroupList)
+		}
+		if _, found := canaries[group]; !found {
+			canaries[group] = pod
+		}
+	}
+
+	// Probe in stable order so repeated runs report identically
+	var groups []string
+	for group := range canaries {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	var failures []string
+	for _, group := range groups {
+		pod := canaries[group]
+		if failure := probeKubeletLogs(k8sClient, pod, group); failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+
+	return failures, nil
+}
+
+// probeKubeletLogs fetches one byte of logs for the pod, which makes the
+// apiserver open a connection to the kubelet on the pod's node.
+func probeKubeletLogs(k8sClient kubernetes.Interface, pod *v1.Pod, group string) string {
+	if len(pod.Spec.Containers) == 0 {
+		return ""
+	}
+
+	tailLines := int64(1)
+	limitBytes := int64(1)
+	_, err := k8sClient.CoreV1().Pods(pod.ObjectMeta.Namespace).GetLogs(pod.ObjectMeta.Name, &v1.PodLogOptions{
+		// The container must be named explicitly for multi-container pods
+		Container:  pod.Spec.Containers[0].Name,
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+	}).Do().Raw()
+	if err == nil {
+		return ""
+	}
+
+	where := fmt.Sprintf("node %q", pod.Spec.NodeName)
+	if group != "" {
+		where = fmt.Sprintf("node %q (instance group %q)", pod.Spec.NodeName, group)
+	}
+	return fmt.Sprintf("apiserver cannot reach the kubelet on %s: error fetching logs for pod %q: %v", where, pod.ObjectMeta.Name, err)
+}
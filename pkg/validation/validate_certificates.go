@@ -0,0 +1,101 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// DefaultCertificateExpiryWindow is how far ahead certificate expiry is
+// reported by default; 30 days leaves time to rotate before the outage.
+const DefaultCertificateExpiryWindow = 30 * 24 * time.Hour
+
+// CollectCertificateExpiryFailures reports every certificate that has expired
+// or expires within the window: all keypairs in the cluster keystore (the CA,
+// master, kubelet and client certificates, and etcd certificates where
+// configured), plus the certificate each API endpoint actually serves, read
+// off a TLS handshake.  Certificate expiry is a top cause of sudden cluster
+// outages, and nothing else surfaces it before it happens.
+func CollectCertificateExpiryFailures(keystore fi.CAStore, endpoints []string, window time.Duration) ([]string, error) {
+	var failures []string
+
+	items, err := keystore.List()
+	if err != nil {
+		return nil, fmt.Errorf("error listing keystore: %v", err)
+	}
+
+	for _, item := range items {
+		if item.Type != fi.SecretTypeKeypair {
+			continue
+		}
+
+		cert, err := keystore.FindCert(item.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error reading certificate %q: %v", item.Name, err)
+		}
+		if cert == nil || cert.Certificate == nil {
+			continue
+		}
+
+		if failure := certificateExpiryFailure(fmt.Sprintf("certificate %q", item.Name), cert.Certificate.NotAfter, window); failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint == "" {
+			continue
+		}
+
+		dialer := &net.Dialer{Timeout: apiEndpointDialTimeout}
+		conn, err := tls.DialWithDialer(dialer, "tcp", endpoint+":443", &tls.Config{
+			InsecureSkipVerify: true,
+		})
+		if err != nil {
+			// Reachability is the api-endpoints check's job
+			continue
+		}
+
+		certs := conn.ConnectionState().PeerCertificates
+		if len(certs) != 0 {
+			if failure := certificateExpiryFailure(fmt.Sprintf("certificate served by API endpoint %q", endpoint), certs[0].NotAfter, window); failure != "" {
+				failures = append(failures, failure)
+			}
+		}
+		conn.Close()
+	}
+
+	return failures, nil
+}
+
+// certificateExpiryFailure describes the expiry state of the certificate, or
+// returns "" if it is not expiring within the window.
+func certificateExpiryFailure(what string, notAfter time.Time, window time.Duration) string {
+	now := time.Now()
+	if now.After(notAfter) {
+		return fmt.Sprintf("%s expired %s", what, notAfter.Format(time.RFC3339))
+	}
+	if notAfter.Before(now.Add(window)) {
+		return fmt.Sprintf("%s expires %s", what, notAfter.Format(time.RFC3339))
+	}
+	return ""
+}
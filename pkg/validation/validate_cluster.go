@@ -18,9 +18,15 @@ package validation
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang/glog"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/pkg/api/v1"
 	"k8s.io/kops/pkg/apis/kops"
@@ -28,8 +34,43 @@ import (
 	"k8s.io/kops/upup/pkg/fi"
 )
 
+// Severity levels for validation failures
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Stable machine-readable codes for validation failures
+const (
+	CodeMastersNotReady    = "MastersNotReady"
+	CodeNodesNotReady      = "NodesNotReady"
+	CodeComponentUnhealthy = "ComponentUnhealthy"
+	CodePodUnhealthy       = "PodUnhealthy"
+	CodeAPIServerUnhealthy = "APIServerUnhealthy"
+	CodeNodeUnderPressure  = "NodeUnderPressure"
+	CodeCheckFailed        = "CheckFailed"
+	CodeLoadBalancer       = "LoadBalancer"
+	CodeCertificateExpiry  = "CertificateExpiry"
+	CodeOrphanedInstance   = "OrphanedInstance"
+)
+
+// ValidationFailure is a single validation failure, with a stable code and a
+// severity so pipelines can parse results instead of scraping table output.
+type ValidationFailure struct {
+	Code     string `json:"code"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
 // A cluster to validate
 type ValidationCluster struct {
+	// ClusterName is the name of the validated cluster
+	ClusterName string `json:"clusterName,omitempty"`
+	// Timestamp is when the validation ran
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+	// Failures lists every failure found, with stable codes and severities
+	Failures []ValidationFailure `json:"failures,omitempty"`
+
 	MastersReady         bool              `json:"mastersReady,omitempty"`
 	MastersReadyArray    []*ValidationNode `json:"mastersReadyArray,omitempty"`
 	MastersNotReadyArray []*ValidationNode `json:"mastersNotReadyArray,omitempty"`
@@ -44,6 +85,54 @@ type ValidationCluster struct {
 
 	ComponentFailures []string `json:"componentFailures,omitempty"`
 	PodFailures       []string `json:"podFailures,omitempty"`
+
+	// APIServerHealthFailures lists the named kube-apiserver health checks that are failing,
+	// parsed from the verbose /healthz output
+	APIServerHealthFailures []string `json:"apiServerHealthFailures,omitempty"`
+
+	// CheckFailures lists the failures reported by registered ValidationChecks,
+	// prefixed with the check name
+	CheckFailures []string `json:"checkFailures,omitempty"`
+
+	// LoadBalancerFailures lists the API load balancer targets that are not
+	// healthy at the cloud level; filled in by the caller, since the cloud
+	// query needs credentials this package does not have
+	LoadBalancerFailures []string `json:"loadBalancerFailures,omitempty"`
+
+	// SkippedInstanceGroups names the instance groups excluded by
+	// ScopeValidationToInstanceGroups, so scoped results are not mistaken
+	// for full validations
+	SkippedInstanceGroups []string `json:"skippedInstanceGroups,omitempty"`
+
+	// CertificateFailures lists the certificates that have expired or are
+	// about to; filled in by the caller, which has keystore access
+	CertificateFailures []string `json:"certificateFailures,omitempty"`
+
+	// OrphanResourceFailures lists cloud resources tagged for the cluster
+	// that no instance group accounts for; filled in by the caller, which
+	// can enumerate the cloud
+	OrphanResourceFailures []string `json:"orphanResourceFailures,omitempty"`
+
+	// Zones summarizes node health per zone, so an AZ-level failure is
+	// visible directly instead of having to be inferred from the individual
+	// node failures
+	Zones []*ValidationZone `json:"zones,omitempty"`
+}
+
+// ValidationZone summarizes the health of the nodes in one zone
+type ValidationZone struct {
+	// Zone is the failure-domain zone name; nodes without a zone label are
+	// aggregated under the empty name
+	Zone string `json:"zone,omitempty"`
+
+	MastersReady    int `json:"mastersReady,omitempty"`
+	MastersNotReady int `json:"mastersNotReady,omitempty"`
+	NodesReady      int `json:"nodesReady,omitempty"`
+	NodesNotReady   int `json:"nodesNotReady,omitempty"`
+
+	// DegradedInstanceGroups names the instance groups with not-ready nodes
+	// in this zone, where the node could be attributed to a group
+	DegradedInstanceGroups []string `json:"degradedInstanceGroups,omitempty"`
 }
 
 // A K8s node to be validated
@@ -52,20 +141,105 @@ type ValidationNode struct {
 	Role     string             `json:"role,omitempty"`
 	Hostname string             `json:"hostname,omitempty"`
 	Status   v1.ConditionStatus `json:"status,omitempty"`
+
+	// Cordoned is true if the node is marked unschedulable; an operator
+	// deliberately took it out of service, so its state is a warning rather
+	// than a hard failure
+	Cordoned bool `json:"cordoned,omitempty"`
+}
+
+// HasWarnings is true if any reported failure has severity warning.
+func (v *ValidationCluster) HasWarnings() bool {
+	for i := range v.Failures {
+		if v.Failures[i].Severity == SeverityWarning {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *ValidationCluster) addFailure(code string, severity string, format string, args ...interface{}) {
+	v.Failures = append(v.Failures, ValidationFailure{
+		Code:     code,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// AddLoadBalancerFailures merges cloud-level API load balancer health failures
+// into the validation result.  DNS can be fine while half the load balancer
+// targets are failing, so these are errors, not warnings.
+func (v *ValidationCluster) AddLoadBalancerFailures(failures []string) {
+	for _, failure := range failures {
+		v.LoadBalancerFailures = append(v.LoadBalancerFailures, failure)
+		v.addFailure(CodeLoadBalancer, SeverityError, "API load balancer target is unhealthy: %s", failure)
+	}
+}
+
+// AddCertificateFailures merges certificate expiry findings into the
+// validation result.  They are warnings: the cluster still works today, but
+// will stop without operator action.
+func (v *ValidationCluster) AddCertificateFailures(failures []string) {
+	for _, failure := range failures {
+		v.CertificateFailures = append(v.CertificateFailures, failure)
+		v.addFailure(CodeCertificateExpiry, SeverityWarning, "%s", failure)
+	}
+}
+
+// AddOrphanResourceFailures merges orphaned cloud resources into the
+// validation result.  They are warnings: the cluster itself is healthy, but
+// the strays cost money and can collide with rolling updates.
+func (v *ValidationCluster) AddOrphanResourceFailures(failures []string) {
+	for _, failure := range failures {
+		v.OrphanResourceFailures = append(v.OrphanResourceFailures, failure)
+		v.addFailure(CodeOrphanedInstance, SeverityWarning, "%s", failure)
+	}
+}
+
+// Tolerance is a failure budget applied to worker node readiness: a cluster with
+// no more than the tolerated number of not-ready nodes still validates, although
+// the degraded nodes are still reported.  Masters are never tolerated.
+type Tolerance struct {
+	// MaxUnreadyNodes is the absolute number of not-ready worker nodes to tolerate
+	MaxUnreadyNodes int
+	// MaxUnreadyPercent is the percentage of expected worker nodes allowed to be not ready
+	MaxUnreadyPercent int
+}
+
+// budget returns the number of not-ready nodes tolerated for the expected node count
+func (t *Tolerance) budget(expected int) int {
+	budget := t.MaxUnreadyNodes
+	if percentBudget := (expected * t.MaxUnreadyPercent) / 100; percentBudget > budget {
+		budget = percentBudget
+	}
+	return budget
 }
 
 // ValidateCluster validate a k8s cluster with a provided instance group list
 func ValidateCluster(clusterName string, instanceGroupList *kops.InstanceGroupList, clusterKubernetesClient kubernetes.Interface) (*ValidationCluster, error) {
+	return ValidateClusterWithTolerance(clusterName, instanceGroupList, clusterKubernetesClient, nil)
+}
+
+// ValidateClusterWithTolerance is ValidateCluster with an optional failure budget
+// for worker nodes, for pipelines that accept some degradation
+func ValidateClusterWithTolerance(clusterName string, instanceGroupList *kops.InstanceGroupList, clusterKubernetesClient kubernetes.Interface, tolerance *Tolerance) (*ValidationCluster, error) {
 	var instanceGroups []*kops.InstanceGroup
-	validationCluster := &ValidationCluster{}
+	validationCluster := &ValidationCluster{
+		ClusterName: clusterName,
+		Timestamp:   metav1.Now(),
+	}
 
 	for i := range instanceGroupList.Items {
 		ig := &instanceGroupList.Items[i]
+		if !instanceGroupInScope(ig.ObjectMeta.Name) {
+			validationCluster.SkippedInstanceGroups = append(validationCluster.SkippedInstanceGroups, ig.ObjectMeta.Name)
+			continue
+		}
 		instanceGroups = append(instanceGroups, ig)
 		if ig.Spec.Role == kops.InstanceGroupRoleMaster {
 			validationCluster.MastersCount += int(fi.Int32Value(ig.Spec.MinSize))
 		} else if ig.Spec.Role == kops.InstanceGroupRoleNode {
-			validationCluster.NodesCount += int(fi.Int32Value(ig.Spec.MinSize))
+			validationCluster.NodesCount += expectedHealthyNodes(ig)
 		}
 	}
 
@@ -83,25 +257,102 @@ func ValidateCluster(clusterName string, instanceGroupList *kops.InstanceGroupLi
 		return nil, fmt.Errorf("error building node adapter for %q: %v", clusterName, err)
 	}
 
-	validationCluster.NodeList, err = nodeAA.GetAllNodes()
-	if err != nil {
-		return nil, fmt.Errorf("cannot get nodes for %q: %v", clusterName, err)
-	}
+	// The collectors are independent API calls; on large clusters running them
+	// sequentially dominates validation time.  Each writes its own field, so the
+	// result is identical to a serial run.
+	var wg sync.WaitGroup
+	errors := make([]error, 5)
+
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		nodeList, err := nodeAA.GetAllNodes()
+		if err != nil {
+			errors[0] = fmt.Errorf("cannot get nodes for %q: %v", clusterName, err)
+			return
+		}
+		validationCluster.NodeList = nodeList
+	}()
+	go func() {
+		defer wg.Done()
+		if validationCheckSkipped(CheckComponents) {
+			return
+		}
+		failures, err := collectComponentFailures(clusterKubernetesClient)
+		if err != nil {
+			errors[1] = fmt.Errorf("cannot get component status for %q: %v", clusterName, err)
+			return
+		}
+		validationCluster.ComponentFailures = failures
+	}()
+	go func() {
+		defer wg.Done()
+		if validationCheckSkipped(CheckPods) {
+			return
+		}
+		failures, err := collectPodFailures(clusterKubernetesClient)
+		if err != nil {
+			errors[2] = fmt.Errorf("cannot get pod health for %q: %v", clusterName, err)
+			return
+		}
+		validationCluster.PodFailures = failures
+	}()
+	go func() {
+		defer wg.Done()
+		if validationCheckSkipped(CheckAPIServerHealth) {
+			return
+		}
+		failures, err := collectAPIServerHealthFailures(clusterKubernetesClient)
+		if err != nil {
+			errors[3] = fmt.Errorf("cannot get apiserver health for %q: %v", clusterName, err)
+			return
+		}
+		validationCluster.APIServerHealthFailures = failures
+	}()
+	go func() {
+		defer wg.Done()
+		failures, err := runValidationChecks(clusterName, instanceGroupList, clusterKubernetesClient)
+		if err != nil {
+			errors[4] = fmt.Errorf("cannot run validation checks for %q: %v", clusterName, err)
+			return
+		}
+		validationCluster.CheckFailures = failures
+	}()
+	wg.Wait()
 
-	validationCluster.ComponentFailures, err = collectComponentFailures(clusterKubernetesClient)
-	if err != nil {
-		return nil, fmt.Errorf("cannot get component status for %q: %v", clusterName, err)
+	for _, err := range errors {
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	validationCluster.PodFailures, err = collectPodFailures(clusterKubernetesClient)
-	if err != nil {
-		return nil, fmt.Errorf("cannot get pod health for %q: %v", clusterName, err)
+	if len(validationCluster.SkippedInstanceGroups) != 0 && validationCluster.NodeList != nil {
+		var scoped []v1.Node
+		for i := range validationCluster.NodeList.Items {
+			node := &validationCluster.NodeList.Items[i]
+			if instanceGroupInScope(instanceGroupNameForNode(node, instanceGroupList)) {
+				scoped = append(scoped, *node)
+			}
+		}
+		validationCluster.NodeList.Items = scoped
 	}
 
-	return validateTheNodes(clusterName, validationCluster)
+	return validateTheNodes(clusterName, validationCluster, instanceGroupList, tolerance)
 
 }
 
+// expectedHealthyNodes returns the number of ready nodes an instance group must
+// contribute for validation to pass: MinSize, scaled down by the group's
+// MinHealthyPercent (if set) so large autoscaled groups fluctuating by an
+// instance or two do not flip validation
+func expectedHealthyNodes(ig *kops.InstanceGroup) int {
+	minSize := int(fi.Int32Value(ig.Spec.MinSize))
+	if ig.Spec.MinHealthyPercent == nil {
+		return minSize
+	}
+	return (minSize * int(fi.Int32Value(ig.Spec.MinHealthyPercent))) / 100
+}
+
 func collectComponentFailures(client kubernetes.Interface) (failures []string, err error) {
 	componentList, err := client.CoreV1().ComponentStatuses().List(metav1.ListOptions{})
 	if err == nil {
@@ -116,30 +367,259 @@ func collectComponentFailures(client kubernetes.Interface) (failures []string, e
 	return
 }
 
+// PodValidationScope selects pods to include in pod validation beyond the
+// built-in kube-system scope.  An empty Selector includes every pod in the
+// namespace.
+type PodValidationScope struct {
+	Namespace string
+	Selector  string
+}
+
+var podValidationScopesMutex sync.Mutex
+var podValidationScopes []PodValidationScope
+
+// RegisterPodValidationScope includes the pods matching the scope in the
+// validation gate, alongside kube-system.  The validate and rolling-update
+// commands register scopes from their --pod-namespace/--pod-selector flags.
+func RegisterPodValidationScope(scope PodValidationScope) {
+	podValidationScopesMutex.Lock()
+	defer podValidationScopesMutex.Unlock()
+
+	podValidationScopes = append(podValidationScopes, scope)
+}
+
+// registeredPodValidationScopes returns the scopes pod validation covers,
+// always starting with kube-system.
+func registeredPodValidationScopes() []PodValidationScope {
+	podValidationScopesMutex.Lock()
+	defer podValidationScopesMutex.Unlock()
+
+	scopes := []PodValidationScope{{Namespace: "kube-system"}}
+	return append(scopes, podValidationScopes...)
+}
+
+var instanceGroupScopeMutex sync.Mutex
+var instanceGroupScope map[string]bool
+
+// ScopeValidationToInstanceGroups restricts node validation to the named
+// instance groups: other groups contribute no expected nodes, and nodes
+// attributed to them are ignored.  Cluster-wide collectors (components, pods,
+// apiserver health) still run.  The validate command registers the scope from
+// its --instance-group/--instance-group-role flags.
+func ScopeValidationToInstanceGroups(names []string) {
+	instanceGroupScopeMutex.Lock()
+	defer instanceGroupScopeMutex.Unlock()
+
+	instanceGroupScope = make(map[string]bool)
+	for _, name := range names {
+		instanceGroupScope[name] = true
+	}
+}
+
+// instanceGroupInScope is true when no scope is set or the group is in it.
+// Unattributed nodes (empty name) are always in scope; dropping them could
+// hide a broken group.
+func instanceGroupInScope(name string) bool {
+	instanceGroupScopeMutex.Lock()
+	defer instanceGroupScopeMutex.Unlock()
+
+	if instanceGroupScope == nil || name == "" {
+		return true
+	}
+	return instanceGroupScope[name]
+}
+
+// PodIgnoreValidationAnnotation excludes a pod from the validation gate when
+// set to "true".  A broken third-party addon must not permanently block an
+// emergency node roll; the exclusion is logged so it is not forgotten.
+const PodIgnoreValidationAnnotation = "validation.kops.k8s.io/ignore"
+
+var podIgnoreSelectorsMutex sync.Mutex
+var podIgnoreSelectors []labels.Selector
+
+// RegisterPodIgnoreSelector excludes pods matching the label selector from the
+// validation gate.  The validate and rolling-update commands register
+// selectors from their --ignore-pod-selector flags.
+func RegisterPodIgnoreSelector(selector string) error {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return fmt.Errorf("invalid pod selector %q: %v", selector, err)
+	}
+
+	podIgnoreSelectorsMutex.Lock()
+	defer podIgnoreSelectorsMutex.Unlock()
+
+	podIgnoreSelectors = append(podIgnoreSelectors, parsed)
+	return nil
+}
+
+// podExcludedFromValidation returns why the pod is excluded from the
+// validation gate, or "" if it is not.
+func podExcludedFromValidation(pod *v1.Pod) string {
+	if pod.ObjectMeta.Annotations[PodIgnoreValidationAnnotation] == "true" {
+		return fmt.Sprintf("annotation %s", PodIgnoreValidationAnnotation)
+	}
+
+	podIgnoreSelectorsMutex.Lock()
+	defer podIgnoreSelectorsMutex.Unlock()
+
+	for _, selector := range podIgnoreSelectors {
+		if selector.Matches(labels.Set(pod.ObjectMeta.Labels)) {
+			return fmt.Sprintf("selector %q", selector.String())
+		}
+	}
+	return ""
+}
+
 func collectPodFailures(client kubernetes.Interface) (failures []string, err error) {
-	pods, err := client.CoreV1().Pods("kube-system").List(metav1.ListOptions{})
-	if err == nil {
+	for _, scope := range registeredPodValidationScopes() {
+		pods, err := client.CoreV1().Pods(scope.Namespace).List(metav1.ListOptions{LabelSelector: scope.Selector})
+		if err != nil {
+			return nil, err
+		}
 		for _, pod := range pods.Items {
 			if pod.Status.Phase == v1.PodSucceeded {
 				continue
 			}
+
+			unhealthy := false
 			for _, status := range pod.Status.ContainerStatuses {
 				if !status.Ready {
-					failures = append(failures, pod.Name)
+					unhealthy = true
+				}
+			}
+			if unhealthy {
+				if reason := podExcludedFromValidation(&pod); reason != "" {
+					glog.Warningf("excluding unhealthy pod %s/%s from validation: %s", pod.Namespace, pod.Name, reason)
+					continue
+				}
+			}
+
+			for _, status := range pod.Status.ContainerStatuses {
+				if !status.Ready {
+					name := pod.Name
+					if scope.Namespace != "kube-system" {
+						name = scope.Namespace + "/" + pod.Name
+					}
+					failures = append(failures, name)
 				}
 			}
 		}
 	}
-	return
+	return failures, nil
+}
+
+// collectAPIServerHealthFailures queries the verbose kube-apiserver /healthz endpoint
+// and returns the names of the health checks that are failing.  This catches cases
+// where individual checks (e.g. etcd) are broken but the node still looks Ready.
+func collectAPIServerHealthFailures(client kubernetes.Interface) (failures []string, err error) {
+	body, err := client.Discovery().RESTClient().Get().AbsPath("/healthz").Param("verbose", "").DoRaw()
+	if err != nil {
+		// The endpoint itself is unhealthy; report it as a single failure rather than an error,
+		// so that the rest of the validation output is still rendered
+		glog.V(2).Infof("error from /healthz: %v", err)
+		return []string{"healthz"}, nil
+	}
+
+	// Verbose output contains one line per check: "[+]ping ok" or "[-]etcd failed: ..."
+	for _, line := range strings.Split(string(body), "\n") {
+		if strings.HasPrefix(line, "[-]") {
+			name := strings.TrimPrefix(line, "[-]")
+			if i := strings.Index(name, " "); i >= 0 {
+				name = name[:i]
+			}
+			failures = append(failures, name)
+		}
+	}
+	return failures, nil
+}
+
+// scaleDownTaintKey is the taint the cluster-autoscaler places on nodes it has
+// selected for removal
+const scaleDownTaintKey = "ToBeDeletedByClusterAutoscaler"
+
+// isBeingScaledDown is true if the cluster-autoscaler has marked the node for deletion
+func isBeingScaledDown(node *v1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == scaleDownTaintKey {
+			return true
+		}
+	}
+	return false
+}
+
+// pressureConditionTypes are the node conditions that are bad when true,
+// unlike Ready which is bad when false.
+var pressureConditionTypes = []v1.NodeConditionType{
+	v1.NodeOutOfDisk,
+	v1.NodeMemoryPressure,
+	v1.NodeDiskPressure,
+	v1.NodeInodePressure,
+	v1.NodeNetworkUnavailable,
+}
+
+// nodePressureFailure is a node condition in its bad state.
+type nodePressureFailure struct {
+	conditionType v1.NodeConditionType
+	message       string
+}
+
+// collectNodePressure returns the pressure conditions the node currently
+// reports as true.
+func collectNodePressure(node *v1.Node) []nodePressureFailure {
+	var failures []nodePressureFailure
+	for _, condition := range node.Status.Conditions {
+		for _, conditionType := range pressureConditionTypes {
+			if condition.Type == conditionType && condition.Status == v1.ConditionTrue {
+				message := condition.Message
+				if message == "" {
+					message = condition.Reason
+				}
+				failures = append(failures, nodePressureFailure{conditionType: conditionType, message: message})
+			}
+		}
+	}
+	return failures
+}
+
+// sortedZoneSummaries orders the per-zone summaries by zone name and
+// deduplicates the degraded instance group names, for stable output
+func sortedZoneSummaries(zones map[string]*ValidationZone) []*ValidationZone {
+	var summaries []*ValidationZone
+	for _, zone := range zones {
+		if len(zone.DegradedInstanceGroups) != 0 {
+			seen := make(map[string]bool)
+			var deduped []string
+			for _, name := range zone.DegradedInstanceGroups {
+				if !seen[name] {
+					seen[name] = true
+					deduped = append(deduped, name)
+				}
+			}
+			sort.Strings(deduped)
+			zone.DegradedInstanceGroups = deduped
+		}
+		summaries = append(summaries, zone)
+	}
+	sort.Sort(byZoneName(summaries))
+	return summaries
 }
 
-func validateTheNodes(clusterName string, validationCluster *ValidationCluster) (*ValidationCluster, error) {
+type byZoneName []*ValidationZone
+
+func (a byZoneName) Len() int           { return len(a) }
+func (a byZoneName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byZoneName) Less(i, j int) bool { return a[i].Zone < a[j].Zone }
+
+func validateTheNodes(clusterName string, validationCluster *ValidationCluster, instanceGroupList *kops.InstanceGroupList, tolerance *Tolerance) (*ValidationCluster, error) {
 	nodes := validationCluster.NodeList
 
 	if nodes == nil || len(nodes.Items) == 0 {
 		return validationCluster, fmt.Errorf("No nodes found in validationCluster")
 	}
 
+	zones := make(map[string]*ValidationZone)
+
 	for i := range nodes.Items {
 		node := &nodes.Items[i]
 
@@ -153,37 +633,144 @@ func validateTheNodes(clusterName string, validationCluster *ValidationCluster)
 			Hostname: node.ObjectMeta.Labels["kubernetes.io/hostname"],
 			Role:     role,
 			Status:   GetNodeConditionStatus(node),
+			Cordoned: node.Spec.Unschedulable,
 		}
 
 		ready := IsNodeOrMasterReady(node)
 
+		// A node can be Ready while already degrading; surface the pressure
+		// conditions so operators see it before the kubelet starts evicting
+		for _, failure := range collectNodePressure(node) {
+			severity := SeverityWarning
+			if failure.conditionType == v1.NodeNetworkUnavailable {
+				// Pods on a node without pod networking are broken now,
+				// not eventually
+				severity = SeverityError
+			}
+			validationCluster.addFailure(CodeNodeUnderPressure, severity, "node %q has condition %s: %s", node.ObjectMeta.Name, failure.conditionType, failure.message)
+		}
+
+		// A node the cluster-autoscaler is deliberately removing is expected to go
+		// NotReady; don't let a legitimate scale-down flip validation
+		if !ready && n.Role == "node" && isBeingScaledDown(node) {
+			glog.V(2).Infof("ignoring node %q: being removed by the cluster-autoscaler", node.Name)
+			continue
+		}
+
+		zone := zones[n.Zone]
+		if zone == nil {
+			zone = &ValidationZone{Zone: n.Zone}
+			zones[n.Zone] = zone
+		}
+
 		// TODO: Use instance group role instead...
 		if n.Role == "master" {
 			if ready {
 				validationCluster.MastersReadyArray = append(validationCluster.MastersReadyArray, n)
+				zone.MastersReady++
 			} else {
 				validationCluster.MastersNotReadyArray = append(validationCluster.MastersNotReadyArray, n)
+				zone.MastersNotReady++
 			}
 		} else if n.Role == "node" {
 			if ready {
 				validationCluster.NodesReadyArray = append(validationCluster.NodesReadyArray, n)
+				zone.NodesReady++
 			} else {
 				validationCluster.NodesNotReadyArray = append(validationCluster.NodesNotReadyArray, n)
+				zone.NodesNotReady++
 			}
 
 		}
+
+		if !ready {
+			if ig := instanceGroupNameForNode(node, instanceGroupList); ig != "" {
+				zone.DegradedInstanceGroups = append(zone.DegradedInstanceGroups, ig)
+			}
+		}
 	}
 
+	validationCluster.Zones = sortedZoneSummaries(zones)
+
 	validationCluster.MastersReady = true
 	if len(validationCluster.MastersNotReadyArray) != 0 || validationCluster.MastersCount != len(validationCluster.MastersReadyArray) {
 		validationCluster.MastersReady = false
 	}
 
+	// Cordoned nodes were deliberately taken out of service; their state is
+	// reported as a warning, not a hard failure
+	cordoned := 0
+	for _, n := range validationCluster.NodesNotReadyArray {
+		if n.Cordoned {
+			cordoned++
+		}
+	}
+
 	validationCluster.NodesReady = true
-	if len(validationCluster.NodesNotReadyArray) != 0 || validationCluster.NodesCount > len(validationCluster.NodesReadyArray) {
+	if len(validationCluster.NodesNotReadyArray) > cordoned || validationCluster.NodesCount > len(validationCluster.NodesReadyArray)+cordoned {
 		validationCluster.NodesReady = false
 	}
 
+	if !validationCluster.NodesReady && tolerance != nil {
+		unready := validationCluster.NodesCount - len(validationCluster.NodesReadyArray)
+		if notReady := len(validationCluster.NodesNotReadyArray); notReady > unready {
+			unready = notReady
+		}
+		if budget := tolerance.budget(validationCluster.NodesCount); unready <= budget {
+			glog.Infof("%d node(s) not ready, within tolerated failure budget of %d", unready, budget)
+			validationCluster.NodesReady = true
+		}
+	}
+
+	if !validationCluster.MastersReady {
+		validationCluster.addFailure(CodeMastersNotReady, SeverityError, "expected %d masters ready, %d are ready", validationCluster.MastersCount, len(validationCluster.MastersReadyArray))
+		for _, n := range validationCluster.MastersNotReadyArray {
+			validationCluster.addFailure(CodeMastersNotReady, SeverityError, "master %q is not ready", n.Hostname)
+		}
+	}
+	if !validationCluster.NodesReady {
+		validationCluster.addFailure(CodeNodesNotReady, SeverityError, "expected %d nodes ready, %d are ready", validationCluster.NodesCount, len(validationCluster.NodesReadyArray))
+	}
+	// Not-ready nodes within a tolerated failure budget are still reported, as warnings
+	severity := SeverityError
+	if validationCluster.NodesReady {
+		severity = SeverityWarning
+	}
+	for _, n := range validationCluster.NodesNotReadyArray {
+		if n.Cordoned {
+			validationCluster.addFailure(CodeNodesNotReady, SeverityWarning, "node %q is not ready (cordoned)", n.Hostname)
+			continue
+		}
+		validationCluster.addFailure(CodeNodesNotReady, severity, "node %q is not ready", n.Hostname)
+	}
+	// A whole zone going dark is a different incident from scattered node
+	// failures; call it out explicitly
+	for _, zone := range validationCluster.Zones {
+		if zone.Zone == "" {
+			continue
+		}
+		if zone.MastersNotReady+zone.NodesNotReady == 0 || zone.MastersReady+zone.NodesReady != 0 {
+			continue
+		}
+		zoneSeverity := severity
+		if zone.MastersNotReady != 0 {
+			zoneSeverity = SeverityError
+		}
+		validationCluster.addFailure(CodeNodesNotReady, zoneSeverity, "zone %q has no ready nodes (%d not ready)", zone.Zone, zone.MastersNotReady+zone.NodesNotReady)
+	}
+	for _, component := range validationCluster.ComponentFailures {
+		validationCluster.addFailure(CodeComponentUnhealthy, SeverityError, "component %q is unhealthy", component)
+	}
+	for _, pod := range validationCluster.PodFailures {
+		validationCluster.addFailure(CodePodUnhealthy, SeverityError, "kube-system pod %q is not healthy", pod)
+	}
+	for _, check := range validationCluster.APIServerHealthFailures {
+		validationCluster.addFailure(CodeAPIServerUnhealthy, SeverityError, "kube-apiserver health check %q is failing", check)
+	}
+	for _, failure := range validationCluster.CheckFailures {
+		validationCluster.addFailure(CodeCheckFailed, SeverityError, "%s", failure)
+	}
+
 	if !validationCluster.MastersReady {
 		return validationCluster, fmt.Errorf("your masters are NOT ready %s", clusterName)
 	}
@@ -200,5 +787,13 @@ func validateTheNodes(clusterName string, validationCluster *ValidationCluster)
 		return validationCluster, fmt.Errorf("your kube-system pods are NOT healthy %s", clusterName)
 	}
 
+	if len(validationCluster.APIServerHealthFailures) != 0 {
+		return validationCluster, fmt.Errorf("your kube-apiserver health checks are failing %s: %s", clusterName, strings.Join(validationCluster.APIServerHealthFailures, ","))
+	}
+
+	if len(validationCluster.CheckFailures) != 0 {
+		return validationCluster, fmt.Errorf("your cluster checks are failing %s: %s", clusterName, strings.Join(validationCluster.CheckFailures, ","))
+	}
+
 	return validationCluster, nil
 }
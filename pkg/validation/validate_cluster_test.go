@@ -35,7 +35,7 @@ func Test_ValidateClusterPositive(t *testing.T) {
 	}
 
 	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 1, MastersCount: 1}
-	validationCluster, err = validateTheNodes("foo", validationCluster)
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
 
 	if err != nil {
 		printDebug(validationCluster)
@@ -51,7 +51,7 @@ func Test_ValidateClusterMasterAndNodeNotReady(t *testing.T) {
 	}
 
 	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 1, MastersCount: 1}
-	validationCluster, err = validateTheNodes("foo", validationCluster)
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
 
 	if err == nil {
 		printDebug(validationCluster)
@@ -68,7 +68,7 @@ func Test_ValidateClusterComponents(t *testing.T) {
 
 	var component = make([]string, 1)
 	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 1, MastersCount: 1, ComponentFailures: component}
-	validationCluster, err = validateTheNodes("foo", validationCluster)
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
 
 	if err == nil {
 		printDebug(validationCluster)
@@ -85,7 +85,7 @@ func Test_ValidateClusterPods(t *testing.T) {
 
 	var pod = make([]string, 1)
 	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 1, MastersCount: 1, PodFailures: pod}
-	validationCluster, err = validateTheNodes("foo", validationCluster)
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
 
 	if err == nil {
 		printDebug(validationCluster)
@@ -101,7 +101,7 @@ func Test_ValidateClusterNodeNotReady(t *testing.T) {
 	}
 
 	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 1, MastersCount: 1}
-	validationCluster, err = validateTheNodes("foo", validationCluster)
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
 
 	if err == nil {
 		printDebug(validationCluster)
@@ -117,7 +117,7 @@ func Test_ValidateClusterMastersNotEnough(t *testing.T) {
 	}
 
 	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 1, MastersCount: 3}
-	validationCluster, err = validateTheNodes("foo", validationCluster)
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
 
 	if err == nil {
 		printDebug(validationCluster)
@@ -133,7 +133,7 @@ func Test_ValidateNodesNotEnough(t *testing.T) {
 	}
 
 	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 3, MastersCount: 1}
-	validationCluster, err = validateTheNodes("foo", validationCluster)
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
 
 	if err == nil {
 		printDebug(validationCluster)
@@ -141,6 +141,127 @@ func Test_ValidateNodesNotEnough(t *testing.T) {
 	}
 }
 
+func Test_ValidateClusterNodeScaleDownIgnored(t *testing.T) {
+	nodeList, err := dummyClient("true", "false").Core().Nodes().List(metav1.ListOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Mark the not-ready node as being removed by the cluster-autoscaler
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if GetNodeConditionStatus(node) != v1.ConditionTrue {
+			node.Spec.Taints = append(node.Spec.Taints, v1.Taint{Key: scaleDownTaintKey, Effect: v1.TaintEffectNoSchedule})
+		}
+	}
+
+	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 1, MastersCount: 1}
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, nil)
+
+	if err != nil {
+		printDebug(validationCluster)
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func Test_ValidateClusterNodeNotReadyTolerated(t *testing.T) {
+	nodeList, err := dummyClient("true", "false").Core().Nodes().List(metav1.ListOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 2, MastersCount: 1}
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, &Tolerance{MaxUnreadyNodes: 1})
+
+	if err != nil {
+		printDebug(validationCluster)
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(validationCluster.NodesNotReadyArray) != 1 {
+		printDebug(validationCluster)
+		t.Fatal("tolerated node should still be reported as not ready")
+	}
+}
+
+func Test_ValidateClusterToleranceExceeded(t *testing.T) {
+	nodeList, err := dummyClient("true", "false").Core().Nodes().List(metav1.ListOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 2, MastersCount: 1}
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, &Tolerance{MaxUnreadyPercent: 10})
+
+	if err == nil {
+		printDebug(validationCluster)
+		t.Fatal("node failure beyond the budget not caught")
+	}
+}
+
+func Test_ValidateClusterToleranceDoesNotCoverMasters(t *testing.T) {
+	nodeList, err := dummyClient("false", "true").Core().Nodes().List(metav1.ListOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 2, MastersCount: 1}
+	validationCluster, err = validateTheNodes("foo", validationCluster, nil, &Tolerance{MaxUnreadyNodes: 5})
+
+	if err == nil {
+		printDebug(validationCluster)
+		t.Fatal("master failure must not be tolerated")
+	}
+}
+
+func Test_ValidateZoneSummary(t *testing.T) {
+	nodeList, err := dummyClient("true", "false").Core().Nodes().List(metav1.ListOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Put the not-ready node alone in its own zone, so that zone has no ready nodes
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		zone := "us-east-1a"
+		if node.ObjectMeta.Name == "node1" {
+			zone = "us-east-1b"
+		}
+		node.ObjectMeta.Labels["failure-domain.beta.kubernetes.io/zone"] = zone
+	}
+
+	validationCluster := &ValidationCluster{NodeList: nodeList, NodesCount: 2, MastersCount: 1}
+	validationCluster, _ = validateTheNodes("foo", validationCluster, nil, nil)
+
+	if len(validationCluster.Zones) != 2 {
+		printDebug(validationCluster)
+		t.Fatalf("expected 2 zone summaries, got %d", len(validationCluster.Zones))
+	}
+	zoneA := validationCluster.Zones[0]
+	zoneB := validationCluster.Zones[1]
+	if zoneA.Zone != "us-east-1a" || zoneA.MastersReady != 1 || zoneA.NodesReady != 1 || zoneA.NodesNotReady != 0 {
+		t.Fatalf("unexpected summary for us-east-1a: %+v", zoneA)
+	}
+	if zoneB.Zone != "us-east-1b" || zoneB.NodesNotReady != 1 || zoneB.NodesReady != 0 {
+		t.Fatalf("unexpected summary for us-east-1b: %+v", zoneB)
+	}
+
+	found := false
+	for _, failure := range validationCluster.Failures {
+		if failure.Code == CodeNodesNotReady && failure.Message == `zone "us-east-1b" has no ready nodes (1 not ready)` {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected a failure reporting that us-east-1b has no ready nodes")
+	}
+}
+
 func Test_ValidateNoPodFailures(t *testing.T) {
 	failures, err := collectPodFailures(dummyPodClient(
 		[]map[string]string{
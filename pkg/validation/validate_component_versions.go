@@ -0,0 +1,99 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// componentVersionComponents are the control-plane static pods whose image
+// version should match the cluster's KubernetesVersion.
+var componentVersionComponents = []string{
+	"kube-apiserver",
+	"kube-controller-manager",
+	"kube-scheduler",
+}
+
+// ComponentVersionCheck verifies that the running control-plane components
+// match the cluster's KubernetesVersion, catching partially-upgraded control
+// planes left behind by a failed rolling update.  It is registered by the
+// validate command, which knows the expected version; it is not useful
+// without one.
+type ComponentVersionCheck struct {
+	// KubernetesVersion is the expected version, from the cluster spec
+	KubernetesVersion string
+}
+
+var _ ValidationCheck = &ComponentVersionCheck{}
+
+func (c *ComponentVersionCheck) Name() string {
+	return "component-version"
+}
+
+func (c *ComponentVersionCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil || c.KubernetesVersion == "" {
+		return nil, nil
+	}
+
+	expected := strings.TrimPrefix(c.KubernetesVersion, "v")
+
+	var failures []string
+	for _, component := range componentVersionComponents {
+		pods, err := k8sClient.CoreV1().Pods("kube-system").List(metav1.ListOptions{LabelSelector: "k8s-app=" + component})
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s pods: %v", component, err)
+		}
+
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			for j := range pod.Spec.Containers {
+				image := pod.Spec.Containers[j].Image
+				version := imageVersion(image)
+				if version == "" {
+					// An untagged or digest-pinned image cannot be compared
+					continue
+				}
+				if version != expected {
+					failures = append(failures, fmt.Sprintf("%s %q runs version %s, expected %s", component, pod.ObjectMeta.Name, version, expected))
+				}
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+// imageVersion extracts the version from an image tag, e.g.
+// "gcr.io/google_containers/kube-apiserver:v1.5.2" yields "1.5.2".
+// It returns "" if the image has no comparable version tag.
+func imageVersion(image string) string {
+	i := strings.LastIndex(image, ":")
+	if i == -1 {
+		return ""
+	}
+	tag := image[i+1:]
+	// A digest reference ("...@sha256:...") is not a version
+	if strings.Contains(image[:i], "@") || strings.Contains(tag, "/") {
+		return ""
+	}
+	return strings.TrimPrefix(tag, "v")
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import "testing"
+
+func TestImageVersion(t *testing.T) {
+	grid := []struct {
+		image    string
+		expected string
+	}{
+		{"gcr.io/google_containers/kube-apiserver:v1.5.2", "1.5.2"},
+		{"gcr.io/google_containers/kube-apiserver:1.5.2", "1.5.2"},
+		{"kube-scheduler", ""},
+		{"myregistry.example.com:5000/kube-apiserver", ""},
+		{"myregistry.example.com:5000/kube-apiserver:v1.5.2", "1.5.2"},
+		{"gcr.io/google_containers/kube-apiserver@sha256:0123456789abcdef", ""},
+	}
+
+	for _, g := range grid {
+		actual := imageVersion(g.image)
+		if actual != g.expected {
+			t.Errorf("imageVersion(%q): expected %q, got %q", g.image, g.expected, actual)
+		}
+	}
+}
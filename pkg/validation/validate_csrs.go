@@ -0,0 +1,85 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	certificatesv1beta1 "k8s.io/client-go/pkg/apis/certificates/v1beta1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func init() {
+	RegisterValidationCheck(&csrCheck{})
+}
+
+// csrPendingGracePeriod is how long a certificate signing request may sit
+// without a decision before validation reports it; freshly submitted requests
+// are normal while an approver catches up
+const csrPendingGracePeriod = 10 * time.Minute
+
+// csrCheck reports certificate signing requests that have been pending for
+// longer than the grace period.  A stuck CSR usually means no approver is
+// running (or the dns-controller approver declined it), and the requesting
+// kubelet cannot serve TLS until someone decides.
+type csrCheck struct{}
+
+var _ ValidationCheck = &csrCheck{}
+
+func (c *csrCheck) Name() string {
+	return "csr"
+}
+
+func (c *csrCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	csrList, err := k8sClient.CertificatesV1beta1().CertificateSigningRequests().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing certificate signing requests: %v", err)
+	}
+
+	cutoff := time.Now().Add(-csrPendingGracePeriod)
+
+	var failures []string
+	for i := range csrList.Items {
+		csr := &csrList.Items[i]
+
+		decided := false
+		for _, condition := range csr.Status.Conditions {
+			if condition.Type == certificatesv1beta1.CertificateApproved || condition.Type == certificatesv1beta1.CertificateDenied {
+				decided = true
+				break
+			}
+		}
+		if decided {
+			continue
+		}
+
+		if csr.ObjectMeta.CreationTimestamp.Time.After(cutoff) {
+			continue
+		}
+
+		failures = append(failures, fmt.Sprintf("certificate signing request %q from %q has been pending since %s; approve or deny it with kubectl certificate", csr.ObjectMeta.Name, csr.Spec.Username, csr.ObjectMeta.CreationTimestamp.Format(time.RFC3339)))
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func init() {
+	RegisterValidationCheck(&daemonSetCoverageCheck{})
+}
+
+// daemonSetCoverageCheck verifies that every ready node runs a ready pod from
+// each kube-system DaemonSet that targets it (CNI, kube-proxy and friends).
+// The aggregate pod checks only see pods that exist; a node a DaemonSet never
+// scheduled onto is invisible to them, yet such a node cannot route pod
+// traffic.
+type daemonSetCoverageCheck struct{}
+
+var _ ValidationCheck = &daemonSetCoverageCheck{}
+
+func (c *daemonSetCoverageCheck) Name() string {
+	return "daemonset-coverage"
+}
+
+func (c *daemonSetCoverageCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	daemonSets, err := k8sClient.ExtensionsV1beta1().DaemonSets("kube-system").List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing kube-system daemonsets: %v", err)
+	}
+	if len(daemonSets.Items) == 0 {
+		return nil, nil
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	var failures []string
+	for i := range daemonSets.Items {
+		ds := &daemonSets.Items[i]
+
+		selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("daemonset %q has an invalid selector: %v", ds.ObjectMeta.Name, err)
+		}
+
+		pods, err := k8sClient.CoreV1().Pods("kube-system").List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, fmt.Errorf("error listing pods for daemonset %q: %v", ds.ObjectMeta.Name, err)
+		}
+
+		covered := make(map[string]bool)
+		for j := range pods.Items {
+			pod := &pods.Items[j]
+			if isPodReady(pod) {
+				covered[pod.Spec.NodeName] = true
+			}
+		}
+
+		for j := range nodes.Items {
+			node := &nodes.Items[j]
+			if !isNodeReady(node) || node.Spec.Unschedulable {
+				// Not-ready and cordoned nodes are reported by the node checks
+				continue
+			}
+			if !nodeSelectorMatches(ds.Spec.Template.Spec.NodeSelector, node) {
+				continue
+			}
+			if !covered[node.ObjectMeta.Name] {
+				failure := fmt.Sprintf("node %q has no ready pod from daemonset %q", node.ObjectMeta.Name, ds.ObjectMeta.Name)
+				if ig := instanceGroupNameForNode(node, instanceGroupList); ig != "" {
+					failure += fmt.Sprintf(" (instance group %q)", ig)
+				}
+				failures = append(failures, failure)
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+// isPodReady returns true when the pod is running with the Ready condition.
+func isPodReady(pod *v1.Pod) bool {
+	if pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// isNodeReady returns true when the node has the Ready condition.
+func isNodeReady(node *v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// nodeSelectorMatches returns true when the node carries every label the pod
+// template's nodeSelector requires.
+func nodeSelectorMatches(nodeSelector map[string]string, node *v1.Node) bool {
+	for k, v := range nodeSelector {
+		if node.ObjectMeta.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// InstanceGroupForNode attributes a node to an instance group, best effort;
+// see instanceGroupNameForNode.  An empty result means the node could not be
+// attributed unambiguously.
+func InstanceGroupForNode(node *v1.Node, instanceGroupList *kops.InstanceGroupList) string {
+	return instanceGroupNameForNode(node, instanceGroupList)
+}
+
+// instanceGroupNameForNode attributes a node to an instance group, best
+// effort.  Nodes are not labelled with their instance group, so we match the
+// node's role and zone against the instance group specs and only attribute
+// when the match is unambiguous.
+func instanceGroupNameForNode(node *v1.Node, instanceGroupList *kops.InstanceGroupList) string {
+	if instanceGroupList == nil {
+		return ""
+	}
+
+	isMaster := node.ObjectMeta.Labels["kubernetes.io/role"] == "master"
+	zone := node.ObjectMeta.Labels["failure-domain.beta.kubernetes.io/zone"]
+
+	match := ""
+	for i := range instanceGroupList.Items {
+		ig := &instanceGroupList.Items[i]
+		if isMaster != (ig.Spec.Role == kops.InstanceGroupRoleMaster) {
+			continue
+		}
+		// Subnets are usually named for their zone, so require a match
+		// when both sides have one
+		if zone != "" && len(ig.Spec.Subnets) != 0 {
+			inZone := false
+			for _, subnet := range ig.Spec.Subnets {
+				if subnet == zone {
+					inZone = true
+				}
+			}
+			if !inZone {
+				continue
+			}
+		}
+		if match != "" {
+			// More than one candidate; attribution would be a guess
+			return ""
+		}
+		match = ig.ObjectMeta.Name
+	}
+
+	return match
+}
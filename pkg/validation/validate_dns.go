@@ -0,0 +1,162 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+const (
+	// dnsProbeImage resolves names with nslookup; the same image the smoke
+	// test uses
+	dnsProbeImage = "busybox:1.24"
+
+	// dnsProbeTimeout bounds how long the probe pod may take to schedule,
+	// run and report
+	dnsProbeTimeout = 90 * time.Second
+
+	dnsProbePollInterval = 3 * time.Second
+)
+
+// DNSResolutionCheck actively exercises in-cluster service DNS, because the
+// kube-dns pods can be Ready while resolution is broken (a wedged dnsmasq, or
+// upstream resolution failing).  It runs an nslookup for the target in a
+// short-lived probe pod, or reads the state of existing probe pods when a
+// selector is configured.  It is registered by the validate command, since
+// creating pods is not something validation should do by default.
+type DNSResolutionCheck struct {
+	// Target is the DNS name the probe resolves
+	Target string
+
+	// ExistingPodSelector, when set, selects already-running probe pods in
+	// kube-system (e.g. managed by a cron job) instead of creating one
+	ExistingPodSelector string
+}
+
+var _ ValidationCheck = &DNSResolutionCheck{}
+
+func (c *DNSResolutionCheck) Name() string {
+	return "cluster-dns"
+}
+
+func (c *DNSResolutionCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	if c.ExistingPodSelector != "" {
+		return c.checkExistingProbes(k8sClient)
+	}
+	return c.runProbe(k8sClient)
+}
+
+// checkExistingProbes reports existing probe pods that have failed; an
+// operator running a periodic DNS probe can point validation at it instead of
+// paying for a pod per validation run.
+func (c *DNSResolutionCheck) checkExistingProbes(k8sClient kubernetes.Interface) ([]string, error) {
+	pods, err := k8sClient.CoreV1().Pods(metav1.NamespaceSystem).List(metav1.ListOptions{LabelSelector: c.ExistingPodSelector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing DNS probe pods: %v", err)
+	}
+	if len(pods.Items) == 0 {
+		return []string{fmt.Sprintf("no DNS probe pods match selector %q in kube-system", c.ExistingPodSelector)}, nil
+	}
+
+	var failures []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == v1.PodFailed {
+			failures = append(failures, fmt.Sprintf("in-cluster DNS resolution is broken: probe pod %q failed: %s", pod.ObjectMeta.Name, probePodLogs(k8sClient, pod)))
+		}
+	}
+	return failures, nil
+}
+
+// runProbe creates a probe pod, waits for it to finish and reports the
+// outcome; the pod is always cleaned up.
+func (c *DNSResolutionCheck) runProbe(k8sClient kubernetes.Interface) ([]string, error) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "dns-probe-",
+			Namespace:    metav1.NamespaceSystem,
+			Labels:       map[string]string{"k8s-app": "kops-dns-probe"},
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy: v1.RestartPolicyNever,
+			Containers: []v1.Container{
+				{
+					Name:    "probe",
+					Image:   dnsProbeImage,
+					Command: []string{"nslookup", c.Target},
+				},
+			},
+		},
+	}
+
+	created, err := k8sClient.CoreV1().Pods(metav1.NamespaceSystem).Create(pod)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DNS probe pod: %v", err)
+	}
+	defer func() {
+		if err := k8sClient.CoreV1().Pods(metav1.NamespaceSystem).Delete(created.ObjectMeta.Name, &metav1.DeleteOptions{}); err != nil {
+			glog.Warningf("error deleting DNS probe pod %q: %v", created.ObjectMeta.Name, err)
+		}
+	}()
+
+	deadline := time.Now().Add(dnsProbeTimeout)
+	for {
+		current, err := k8sClient.CoreV1().Pods(metav1.NamespaceSystem).Get(created.ObjectMeta.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error reading DNS probe pod: %v", err)
+		}
+
+		switch current.Status.Phase {
+		case v1.PodSucceeded:
+			return nil, nil
+		case v1.PodFailed:
+			return []string{fmt.Sprintf("in-cluster DNS resolution of %q is broken: %s", c.Target, probePodLogs(k8sClient, current))}, nil
+		}
+
+		if time.Now().After(deadline) {
+			// Probably a scheduling problem rather than DNS, but the
+			// operator needs to look either way
+			return []string{fmt.Sprintf("DNS probe pod did not complete within %s (phase %s)", dnsProbeTimeout, current.Status.Phase)}, nil
+		}
+		time.Sleep(dnsProbePollInterval)
+	}
+}
+
+// probePodLogs fetches the tail of the probe pod's logs for the failure
+// message, or explains why it could not.
+func probePodLogs(k8sClient kubernetes.Interface, pod *v1.Pod) string {
+	tailLines := int64(5)
+	logs, err := k8sClient.CoreV1().Pods(pod.ObjectMeta.Namespace).GetLogs(pod.ObjectMeta.Name, &v1.PodLogOptions{
+		Container: pod.Spec.Containers[0].Name,
+		TailLines: &tailLines,
+	}).Do().Raw()
+	if err != nil {
+		return fmt.Sprintf("(cannot fetch probe logs: %v)", err)
+	}
+	return string(logs)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// etcdValidationCheck reports etcd member health, quorum and leader presence.
+// A failed etcd member is otherwise invisible to validation until enough
+// members fail that the apiserver itself goes down.
+//
+// The etcd client ports are not reachable from outside the cluster, so each
+// member's health endpoint is queried through the apiserver pod proxy.
+type etcdValidationCheck struct{}
+
+func init() {
+	RegisterValidationCheck(&etcdValidationCheck{})
+}
+
+func (e *etcdValidationCheck) Name() string {
+	return "etcd"
+}
+
+// etcdPodClusters are the etcd clusters protokube runs as static pods,
+// keyed by the k8s-app label on their pods.
+var etcdPodClusters = []struct {
+	label string
+	port  int
+}{
+	{label: "etcd-server", port: 4001},
+	{label: "etcd-server-events", port: 4002},
+}
+
+func (e *etcdValidationCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	var failures []string
+	for _, etcdCluster := range etcdPodClusters {
+		pods, err := k8sClient.CoreV1().Pods("kube-system").List(metav1.ListOptions{LabelSelector: "k8s-app=" + etcdCluster.label})
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s pods: %v", etcdCluster.label, err)
+		}
+		if len(pods.Items) == 0 {
+			// etcd is not running as kops-managed pods; nothing we can check
+			continue
+		}
+
+		healthy := 0
+		leaderFound := false
+		for i := range pods.Items {
+			podName := pods.Items[i].ObjectMeta.Name
+
+			if err := etcdMemberHealth(k8sClient, podName, etcdCluster.port); err != nil {
+				failures = append(failures, fmt.Sprintf("etcd member %q is not healthy: %v", podName, err))
+				continue
+			}
+			healthy++
+
+			isLeader, err := etcdMemberIsLeader(k8sClient, podName, etcdCluster.port)
+			if err == nil && isLeader {
+				leaderFound = true
+			}
+		}
+
+		if quorum := len(pods.Items)/2 + 1; healthy < quorum {
+			failures = append(failures, fmt.Sprintf("etcd cluster %q has lost quorum: %d of %d members are healthy", etcdCluster.label, healthy, len(pods.Items)))
+		} else if !leaderFound {
+			failures = append(failures, fmt.Sprintf("etcd cluster %q has no leader", etcdCluster.label))
+		}
+	}
+
+	return failures, nil
+}
+
+// etcdMemberHealth queries a member's /health endpoint through the apiserver
+// pod proxy.
+func etcdMemberHealth(k8sClient kubernetes.Interface, podName string, port int) error {
+	body, err := k8sClient.Discovery().RESTClient().Get().
+		AbsPath(fmt.Sprintf("/api/v1/namespaces/kube-system/pods/%s:%d/proxy/health", podName, port)).
+		DoRaw()
+	if err != nil {
+		return err
+	}
+
+	health := &struct {
+		Health string `json:"health"`
+	}{}
+	if err := json.Unmarshal(body, health); err != nil {
+		return fmt.Errorf("cannot parse health response: %v", err)
+	}
+	if health.Health != "true" {
+		return fmt.Errorf("member reports health %q", health.Health)
+	}
+	return nil
+}
+
+// etcdMemberIsLeader queries a member's /v2/stats/self endpoint through the
+// apiserver pod proxy.
+func etcdMemberIsLeader(k8sClient kubernetes.Interface, podName string, port int) (bool, error) {
+	body, err := k8sClient.Discovery().RESTClient().Get().
+		AbsPath(fmt.Sprintf("/api/v1/namespaces/kube-system/pods/%s:%d/proxy/v2/stats/self", podName, port)).
+		DoRaw()
+	if err != nil {
+		return false, err
+	}
+
+	stats := &struct {
+		State string `json:"state"`
+	}{}
+	if err := json.Unmarshal(body, stats); err != nil {
+		return false, fmt.Errorf("cannot parse stats response: %v", err)
+	}
+	return stats.State == "StateLeader", nil
+}
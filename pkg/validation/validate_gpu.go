@@ -0,0 +1,107 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func init() {
+	RegisterValidationCheck(&gpuCheck{})
+}
+
+// gpuInstanceTypes maps the AWS GPU instance types to the number of GPUs they
+// carry; an instance group using one of these is expected to advertise that
+// capacity on its nodes
+var gpuInstanceTypes = map[string]int64{
+	"g2.2xlarge":  1,
+	"g2.8xlarge":  4,
+	"p2.xlarge":   1,
+	"p2.8xlarge":  8,
+	"p2.16xlarge": 16,
+}
+
+// gpuCheck verifies that nodes in GPU instance groups advertise the
+// alpha.kubernetes.io/nvidia-gpu capacity their instance type carries.  A node
+// whose nvidia driver hook failed still reports Ready, but with zero GPU
+// capacity, and GPU workloads silently stay unschedulable.  Failures are
+// attributed to the instance group.
+type gpuCheck struct{}
+
+var _ ValidationCheck = &gpuCheck{}
+
+func (c *gpuCheck) Name() string {
+	return "gpu"
+}
+
+func (c *gpuCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil || instanceGroupList == nil {
+		return nil, nil
+	}
+
+	// Expected GPUs per instance group; the kubelet flag overrides the
+	// instance type mapping
+	expectedByGroup := make(map[string]int64)
+	for i := range instanceGroupList.Items {
+		ig := &instanceGroupList.Items[i]
+		expected := gpuInstanceTypes[ig.Spec.MachineType]
+		if ig.Spec.Kubelet != nil && ig.Spec.Kubelet.NvidiaGPUs != 0 {
+			expected = int64(ig.Spec.Kubelet.NvidiaGPUs)
+		}
+		if expected != 0 {
+			expectedByGroup[ig.ObjectMeta.Name] = expected
+		}
+	}
+	if len(expectedByGroup) == 0 {
+		return nil, nil
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	var failures []string
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if !isNodeReady(node) {
+			// Not-ready nodes are reported by the node checks
+			continue
+		}
+
+		group := instanceGroupNameForNode(node, instanceGroupList)
+		expected, found := expectedByGroup[group]
+		if !found {
+			continue
+		}
+
+		capacity := int64(0)
+		if quantity, found := node.Status.Capacity[v1.ResourceNvidiaGPU]; found {
+			capacity = quantity.Value()
+		}
+		if capacity < expected {
+			failures = append(failures, fmt.Sprintf("node %q (instance group %q) advertises %d of %d expected GPUs; the nvidia driver installation probably failed", node.ObjectMeta.Name, group, capacity, expected))
+		}
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/util"
+)
+
+// kubeletVersionMaxSkew is the number of minor versions a kubelet may lag the
+// cluster's expected version before the skew itself is a problem, matching
+// the upstream support policy.  A kubelet within the skew but not at the
+// expected version is still reported, as a stale node.
+const kubeletVersionMaxSkew = 1
+
+// KubeletVersionCheck compares each node's reported kubelet version to the
+// version the cluster spec expects, catching nodes where nodeup silently
+// failed during a rolling update.  Like ComponentVersionCheck it is
+// registered by the validate command, which knows the expected version.
+type KubeletVersionCheck struct {
+	// KubernetesVersion is the expected version, from the cluster spec
+	KubernetesVersion string
+}
+
+var _ ValidationCheck = &KubeletVersionCheck{}
+
+func (c *KubeletVersionCheck) Name() string {
+	return "kubelet-version"
+}
+
+func (c *KubeletVersionCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil || c.KubernetesVersion == "" {
+		return nil, nil
+	}
+
+	expected, err := util.ParseKubernetesVersion(c.KubernetesVersion)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse expected version %q: %v", c.KubernetesVersion, err)
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	var failures []string
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+
+		kubeletVersion := node.Status.NodeInfo.KubeletVersion
+		if kubeletVersion == "" {
+			// The kubelet has not reported in; the node checks cover this
+			continue
+		}
+
+		group := instanceGroupNameForNode(node, instanceGroupList)
+		where := fmt.Sprintf("node %q", node.ObjectMeta.Name)
+		if group != "" {
+			where = fmt.Sprintf("node %q (instance group %q)", node.ObjectMeta.Name, group)
+		}
+
+		if failure := kubeletVersionFailure(where, kubeletVersion, c.KubernetesVersion, expected); failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+
+	return failures, nil
+}
+
+// kubeletVersionFailure classifies a kubelet version against the expected
+// version, or returns "" if they match
+func kubeletVersionFailure(where string, kubeletVersion string, expectedVersion string, expected *semver.Version) string {
+	if strings.TrimPrefix(kubeletVersion, "v") == strings.TrimPrefix(expectedVersion, "v") {
+		return ""
+	}
+
+	actual, err := util.ParseKubernetesVersion(kubeletVersion)
+	if err != nil {
+		return fmt.Sprintf("%s reports unparseable kubelet version %q", where, kubeletVersion)
+	}
+
+	switch {
+	case actual.Major > expected.Major || (actual.Major == expected.Major && actual.Minor > expected.Minor):
+		return fmt.Sprintf("%s runs kubelet %s, newer than the expected %s; the kubelet must not be newer than the control plane", where, kubeletVersion, expectedVersion)
+	case actual.Major < expected.Major || expected.Minor-actual.Minor > kubeletVersionMaxSkew:
+		return fmt.Sprintf("%s runs kubelet %s, more than %d minor version(s) behind the expected %s", where, kubeletVersion, kubeletVersionMaxSkew, expectedVersion)
+	default:
+		return fmt.Sprintf("%s runs kubelet %s, expected %s; nodeup may have failed during the rolling update", where, kubeletVersion, expectedVersion)
+	}
+}
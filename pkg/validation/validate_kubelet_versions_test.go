@@ -0,0 +1,59 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops/util"
+)
+
+func TestKubeletVersionFailure(t *testing.T) {
+	grid := []struct {
+		kubeletVersion  string
+		expectedVersion string
+		// expectedSubstring is "" when the version should pass
+		expectedSubstring string
+	}{
+		{"v1.5.2", "1.5.2", ""},
+		{"v1.5.2", "v1.5.2", ""},
+		{"v1.5.1", "1.5.2", "nodeup may have failed"},
+		{"v1.4.8", "1.5.2", "nodeup may have failed"},
+		{"v1.3.0", "1.5.2", "minor version(s) behind"},
+		{"v1.6.0", "1.5.2", "must not be newer"},
+		{"gibberish", "1.5.2", "unparseable"},
+	}
+
+	expected, err := util.ParseKubernetesVersion("1.5.2")
+	if err != nil {
+		t.Fatalf("unexpected error parsing version: %v", err)
+	}
+
+	for _, g := range grid {
+		actual := kubeletVersionFailure("node \"n1\"", g.kubeletVersion, g.expectedVersion, expected)
+		if g.expectedSubstring == "" {
+			if actual != "" {
+				t.Errorf("kubeletVersionFailure(%q, %q): expected no failure, got %q", g.kubeletVersion, g.expectedVersion, actual)
+			}
+			continue
+		}
+		if !strings.Contains(actual, g.expectedSubstring) {
+			t.Errorf("kubeletVersionFailure(%q, %q): expected %q in %q", g.kubeletVersion, g.expectedVersion, g.expectedSubstring, actual)
+		}
+	}
+}
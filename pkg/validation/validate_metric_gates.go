@@ -0,0 +1,130 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// metricGateTimeout bounds each prometheus query.
+const metricGateTimeout = 30 * time.Second
+
+// MetricGatesCheck evaluates the metric gates from the cluster spec's
+// validation section: prometheus queries with thresholds that let "valid"
+// include application-level health, so a rolling update pauses when an SLO
+// degrades even though every node is Ready.  It is registered by the validate
+// and rolling-update commands, which know the cluster spec.
+type MetricGatesCheck struct {
+	// Gates are the metric gates from the cluster spec
+	Gates []kops.MetricGateSpec
+}
+
+var _ ValidationCheck = &MetricGatesCheck{}
+
+func (c *MetricGatesCheck) Name() string {
+	return "metric-gates"
+}
+
+func (c *MetricGatesCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	var failures []string
+	for i := range c.Gates {
+		gate := &c.Gates[i]
+		if failure, err := evaluateMetricGate(gate); err != nil {
+			return nil, err
+		} else if failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+	return failures, nil
+}
+
+// metricGateQueryResponse is the subset of the prometheus query API response
+// that we read.
+type metricGateQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// evaluateMetricGate runs the gate's query and compares the first sample
+// against the thresholds.  A failing gate is a failure; an unreachable
+// prometheus or a malformed response is an error, since the gate could not be
+// evaluated at all.
+func evaluateMetricGate(gate *kops.MetricGateSpec) (string, error) {
+	queryURL := strings.TrimSuffix(gate.PrometheusURL, "/") + "/api/v1/query?query=" + url.QueryEscape(gate.Query)
+
+	client := &http.Client{Timeout: metricGateTimeout}
+	response, err := client.Get(queryURL)
+	if err != nil {
+		return "", fmt.Errorf("metric gate %q: error querying prometheus at %q: %v", gate.Name, gate.PrometheusURL, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != 200 {
+		return "", fmt.Errorf("metric gate %q: unexpected status %q from prometheus query", gate.Name, response.Status)
+	}
+
+	parsed := &metricGateQueryResponse{}
+	if err := json.NewDecoder(response.Body).Decode(parsed); err != nil {
+		return "", fmt.Errorf("metric gate %q: error parsing prometheus response: %v", gate.Name, err)
+	}
+	if parsed.Status != "success" {
+		return "", fmt.Errorf("metric gate %q: prometheus query returned status %q", gate.Name, parsed.Status)
+	}
+	if len(parsed.Data.Result) == 0 {
+		return fmt.Sprintf("metric gate %q: query %q returned no samples", gate.Name, gate.Query), nil
+	}
+
+	sample := parsed.Data.Result[0].Value
+	if len(sample) != 2 {
+		return "", fmt.Errorf("metric gate %q: malformed sample in prometheus response", gate.Name)
+	}
+	valueString, ok := sample[1].(string)
+	if !ok {
+		return "", fmt.Errorf("metric gate %q: malformed sample value in prometheus response", gate.Name)
+	}
+	value, err := strconv.ParseFloat(valueString, 64)
+	if err != nil {
+		return "", fmt.Errorf("metric gate %q: cannot parse sample value %q: %v", gate.Name, valueString, err)
+	}
+
+	// Thresholds were validated when the spec was saved
+	if gate.MaxValue != "" {
+		if max, err := strconv.ParseFloat(gate.MaxValue, 64); err == nil && value > max {
+			return fmt.Sprintf("metric gate %q: value %v is above the maximum %v", gate.Name, value, max), nil
+		}
+	}
+	if gate.MinValue != "" {
+		if min, err := strconv.ParseFloat(gate.MinValue, 64); err == nil && value < min {
+			return fmt.Sprintf("metric gate %q: value %v is below the minimum %v", gate.Name, value, min), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// nodeDriftAnnotation is set by protokube when a node's running sysctls no
+// longer match what nodeup configured (see protokube's NodeDriftAnnotation).
+const nodeDriftAnnotation = "kops.k8s.io/configuration-drift"
+
+func init() {
+	RegisterValidationCheck(&nodeDriftCheck{})
+}
+
+// nodeDriftCheck surfaces nodes that protokube has flagged as having drifted
+// from their nodeup-applied configuration.
+type nodeDriftCheck struct{}
+
+var _ ValidationCheck = &nodeDriftCheck{}
+
+func (c *nodeDriftCheck) Name() string {
+	return "node-drift"
+}
+
+func (c *nodeDriftCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	var failures []string
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		drift := node.Annotations[nodeDriftAnnotation]
+		if drift != "" {
+			failures = append(failures, fmt.Sprintf("node %q configuration has drifted: %s", node.ObjectMeta.Name, drift))
+		}
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func init() {
+	RegisterValidationCheck(&nodeRegistrationCheck{})
+}
+
+// nodeRegistrationCheck verifies that every ready node completed cloud
+// provider registration: a kubelet that came up without its cloud provider
+// (a common silent failure after a node OS upgrade) reports Ready, but has no
+// provider ID and no zone labels, and volume attach and zone-aware scheduling
+// are broken on it.
+type nodeRegistrationCheck struct{}
+
+var _ ValidationCheck = &nodeRegistrationCheck{}
+
+func (c *nodeRegistrationCheck) Name() string {
+	return "node-registration"
+}
+
+func (c *nodeRegistrationCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	var failures []string
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if !isNodeReady(node) {
+			// Not-ready nodes are reported by the node checks
+			continue
+		}
+
+		if node.Spec.ProviderID == "" && node.Spec.ExternalID == "" {
+			failures = append(failures, fmt.Sprintf("node %q has no provider ID; the kubelet did not register with the cloud provider, so volume attach will fail on it", node.ObjectMeta.Name))
+			continue
+		}
+
+		if node.ObjectMeta.Labels["failure-domain.beta.kubernetes.io/zone"] == "" {
+			failures = append(failures, fmt.Sprintf("node %q has no zone label; cloud provider registration is incomplete", node.ObjectMeta.Name))
+		}
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	policyv1beta1 "k8s.io/client-go/pkg/apis/policy/v1beta1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func init() {
+	RegisterValidationCheck(&pdbCoverageCheck{})
+}
+
+// pdbCoverageCheck reports kube-system Deployments whose PodDisruptionBudget
+// situation will hurt a rolling update: a multi-replica deployment without a
+// PDB can lose every replica to one drain, while a PDB that allows no
+// disruptions stalls drains entirely.
+type pdbCoverageCheck struct{}
+
+var _ ValidationCheck = &pdbCoverageCheck{}
+
+func (c *pdbCoverageCheck) Name() string {
+	return "pdb-coverage"
+}
+
+func (c *pdbCoverageCheck) Validate(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface) ([]string, error) {
+	if k8sClient == nil {
+		return nil, nil
+	}
+
+	deployments, err := k8sClient.ExtensionsV1beta1().Deployments("kube-system").List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing kube-system deployments: %v", err)
+	}
+	if len(deployments.Items) == 0 {
+		return nil, nil
+	}
+
+	pdbs, err := k8sClient.PolicyV1beta1().PodDisruptionBudgets("kube-system").List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing kube-system poddisruptionbudgets: %v", err)
+	}
+
+	var failures []string
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+
+		pdb, err := pdbForPodLabels(pdbs.Items, deployment.Spec.Template.ObjectMeta.Labels)
+		if err != nil {
+			return nil, err
+		}
+
+		if pdb == nil {
+			// A single replica is lost to a drain regardless; only
+			// multi-replica deployments gain anything from a PDB
+			if replicas > 1 {
+				failures = append(failures, fmt.Sprintf("deployment %q has %d replicas but no PodDisruptionBudget; a drain can evict them all at once", deployment.ObjectMeta.Name, replicas))
+			}
+			continue
+		}
+
+		if pdbBlocksDrains(pdb, replicas) {
+			failures = append(failures, fmt.Sprintf("PodDisruptionBudget %q allows no disruptions for deployment %q; drains will stall on its pods", pdb.ObjectMeta.Name, deployment.ObjectMeta.Name))
+		}
+	}
+
+	return failures, nil
+}
+
+// pdbForPodLabels returns the PDB whose selector matches the pod labels, or
+// nil if none does.
+func pdbForPodLabels(pdbs []policyv1beta1.PodDisruptionBudget, podLabels map[string]string) (*policyv1beta1.PodDisruptionBudget, error) {
+	for i := range pdbs {
+		pdb := &pdbs[i]
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("poddisruptionbudget %q has an invalid selector: %v", pdb.ObjectMeta.Name, err)
+		}
+		if selector.Matches(labels.Set(podLabels)) {
+			return pdb, nil
+		}
+	}
+	return nil, nil
+}
+
+// pdbBlocksDrains is true when the budget cannot allow even one voluntary
+// disruption at full replica count.
+func pdbBlocksDrains(pdb *policyv1beta1.PodDisruptionBudget, replicas int32) bool {
+	if pdb.Spec.MaxUnavailable != nil {
+		maxUnavailable, err := intstr.GetValueFromIntOrPercent(pdb.Spec.MaxUnavailable, int(replicas), false)
+		if err == nil && maxUnavailable == 0 {
+			return true
+		}
+		return false
+	}
+
+	if pdb.Spec.MinAvailable != nil {
+		minAvailable, err := intstr.GetValueFromIntOrPercent(pdb.Spec.MinAvailable, int(replicas), true)
+		if err == nil && int32(minAvailable) >= replicas {
+			return true
+		}
+	}
+
+	return false
+}
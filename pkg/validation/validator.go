@@ -0,0 +1,99 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// ClusterValidator validates a cluster, either once or by polling until it
+// passes.  Rolling update and `kops validate cluster --wait` share this
+// implementation instead of each maintaining its own retry loop.
+type ClusterValidator interface {
+	// Validate runs a single validation pass
+	Validate() (*ValidationCluster, error)
+
+	// ValidateWithDeadline polls Validate every interval until the cluster
+	// has passed successfulValidations consecutive times, the context
+	// expires, or the context is canceled.  A failed pass resets the count:
+	// a cluster that flaps between healthy and unhealthy should not be
+	// reported as validated.  The last validation result is returned along
+	// with any error.
+	ValidateWithDeadline(ctx context.Context, interval time.Duration, successfulValidations int) (*ValidationCluster, error)
+}
+
+type clusterValidator struct {
+	clusterName       string
+	instanceGroupList *kops.InstanceGroupList
+	k8sClient         kubernetes.Interface
+	tolerance         *Tolerance
+}
+
+var _ ClusterValidator = &clusterValidator{}
+
+// NewClusterValidator builds a ClusterValidator for the given cluster.  A nil
+// tolerance uses the default tolerance.
+func NewClusterValidator(clusterName string, instanceGroupList *kops.InstanceGroupList, k8sClient kubernetes.Interface, tolerance *Tolerance) ClusterValidator {
+	return &clusterValidator{
+		clusterName:       clusterName,
+		instanceGroupList: instanceGroupList,
+		k8sClient:         k8sClient,
+		tolerance:         tolerance,
+	}
+}
+
+func (v *clusterValidator) Validate() (*ValidationCluster, error) {
+	return ValidateClusterWithTolerance(v.clusterName, v.instanceGroupList, v.k8sClient, v.tolerance)
+}
+
+func (v *clusterValidator) ValidateWithDeadline(ctx context.Context, interval time.Duration, successfulValidations int) (*ValidationCluster, error) {
+	if successfulValidations <= 0 {
+		successfulValidations = 1
+	}
+
+	successes := 0
+	for {
+		result, err := v.Validate()
+		if err == nil {
+			successes++
+			glog.V(2).Infof("cluster %q validated (%d of %d)", v.clusterName, successes, successfulValidations)
+			if successes >= successfulValidations {
+				return result, nil
+			}
+		} else {
+			if successes > 0 {
+				glog.Infof("cluster %q failed validation after %d successful validations, restarting count: %v", v.clusterName, successes, err)
+			}
+			successes = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				err = fmt.Errorf("cluster did not pass %d consecutive validations", successfulValidations)
+			}
+			return result, fmt.Errorf("cluster %q did not validate within the deadline: %v", v.clusterName, err)
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package verification runs the post-rolling-update verification checks
+// configured in the cluster spec.
+package verification
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+	batchv1 "k8s.io/client-go/pkg/apis/batch/v1"
+	api "k8s.io/kops/pkg/apis/kops"
+)
+
+const (
+	// defaultCheckTimeout bounds a check when TimeoutSeconds is not set
+	defaultCheckTimeout = 5 * time.Minute
+
+	// jobPollInterval is how often we poll a verification Job for completion
+	jobPollInterval = 10 * time.Second
+
+	// jobNamespace is where verification Jobs are run
+	jobNamespace = "kube-system"
+)
+
+// CheckResult records the outcome of a single verification check.
+type CheckResult struct {
+	// Name is the check name from the cluster spec
+	Name string `json:"name"`
+	// Type is the check type (http, job or prometheus)
+	Type string `json:"type"`
+	// Passed is true if the check succeeded
+	Passed bool `json:"passed"`
+	// Message describes a failure; empty when the check passed
+	Message string `json:"message,omitempty"`
+	// Duration is how long the check took
+	Duration time.Duration `json:"duration"`
+}
+
+// Report is the structured outcome of a verification run.
+type Report struct {
+	Results []CheckResult `json:"results"`
+}
+
+// Passed is true if every check in the report succeeded.
+func (r *Report) Passed() bool {
+	for i := range r.Results {
+		if !r.Results[i].Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Failed returns the results of the checks that did not pass.
+func (r *Report) Failed() []CheckResult {
+	var failed []CheckResult
+	for i := range r.Results {
+		if !r.Results[i].Passed {
+			failed = append(failed, r.Results[i])
+		}
+	}
+	return failed
+}
+
+// RunChecks executes the checks sequentially and returns a report covering all
+// of them; individual check failures are recorded, not returned as errors.
+// k8sClient may be nil if no job checks are configured.
+func RunChecks(checks []api.VerificationCheckSpec, k8sClient kubernetes.Interface) *Report {
+	report := &Report{}
+
+	for i := range checks {
+		check := &checks[i]
+
+		glog.Infof("Running verification check %q (%s)", check.Name, check.Type)
+		start := time.Now()
+
+		var err error
+		switch check.Type {
+		case "http":
+			err = runHTTPCheck(check)
+		case "job":
+			err = runJobCheck(check, k8sClient)
+		case "prometheus":
+			err = runPrometheusCheck(check)
+		default:
+			err = fmt.Errorf("unknown check type %q", check.Type)
+		}
+
+		result := CheckResult{
+			Name:     check.Name,
+			Type:     check.Type,
+			Passed:   err == nil,
+			Duration: time.Since(start),
+		}
+		if err != nil {
+			result.Message = err.Error()
+			glog.Warningf("Verification check %q failed: %v", check.Name, err)
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+func checkTimeout(check *api.VerificationCheckSpec) time.Duration {
+	if check.TimeoutSeconds > 0 {
+		return time.Duration(check.TimeoutSeconds) * time.Second
+	}
+	return defaultCheckTimeout
+}
+
+// runHTTPCheck fetches the URL; any 2xx response passes.
+func runHTTPCheck(check *api.VerificationCheckSpec) error {
+	client := &http.Client{Timeout: checkTimeout(check)}
+
+	response, err := client.Get(check.URL)
+	if err != nil {
+		return fmt.Errorf("error fetching %q: %v", check.URL, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %q from %q", response.Status, check.URL)
+	}
+	return nil
+}
+
+// prometheusQueryResponse is the subset of the prometheus query API response
+// that we read.
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// runPrometheusCheck runs the query against the prometheus HTTP API; the check
+// passes if the query returns at least one sample and no sample is zero.
+func runPrometheusCheck(check *api.VerificationCheckSpec) error {
+	queryURL := strings.TrimSuffix(check.PrometheusURL, "/") + "/api/v1/query?query=" + url.QueryEscape(check.Query)
+
+	client := &http.Client{Timeout: checkTimeout(check)}
+	response, err := client.Get(queryURL)
+	if err != nil {
+		return fmt.Errorf("error querying prometheus at %q: %v", check.PrometheusURL, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != 200 {
+		return fmt.Errorf("unexpected status %q from prometheus query", response.Status)
+	}
+
+	parsed := &prometheusQueryResponse{}
+	if err := json.NewDecoder(response.Body).Decode(parsed); err != nil {
+		return fmt.Errorf("error parsing prometheus response: %v", err)
+	}
+	if parsed.Status != "success" {
+		return fmt.Errorf("prometheus query returned status %q", parsed.Status)
+	}
+	if len(parsed.Data.Result) == 0 {
+		return fmt.Errorf("prometheus query %q returned no samples", check.Query)
+	}
+
+	for _, sample := range parsed.Data.Result {
+		// A sample value is a [timestamp, "value"] pair
+		if len(sample.Value) != 2 {
+			continue
+		}
+		value, ok := sample.Value[1].(string)
+		if !ok {
+			continue
+		}
+		if value == "0" {
+			return fmt.Errorf("prometheus query %q returned a zero sample", check.Query)
+		}
+	}
+	return nil
+}
+
+// runJobCheck runs a Job in kube-system and waits for it to complete.
+func runJobCheck(check *api.VerificationCheckSpec, k8sClient kubernetes.Interface) error {
+	if k8sClient == nil {
+		return fmt.Errorf("job checks require access to the kubernetes API")
+	}
+
+	jobName := fmt.Sprintf("kops-verify-%s-%d", jobNameSafe(check.Name), time.Now().Unix())
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: jobNamespace,
+			Labels:    map[string]string{"k8s-addon": "kops-verification"},
+		},
+		Spec: batchv1.JobSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers: []v1.Container{
+						{
+							Name:    "verify",
+							Image:   check.Image,
+							Command: check.Command,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	created, err := k8sClient.BatchV1().Jobs(jobNamespace).Create(job)
+	if err != nil {
+		return fmt.Errorf("error creating verification job %q: %v", jobName, err)
+	}
+
+	defer func() {
+		err := k8sClient.BatchV1().Jobs(jobNamespace).Delete(created.ObjectMeta.Name, nil)
+		if err != nil {
+			glog.Warningf("error deleting verification job %q: %v", created.ObjectMeta.Name, err)
+		}
+	}()
+
+	deadline := time.Now().Add(checkTimeout(check))
+	for {
+		job, err := k8sClient.BatchV1().Jobs(jobNamespace).Get(created.ObjectMeta.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error polling verification job %q: %v", created.ObjectMeta.Name, err)
+		}
+
+		if job.Status.Succeeded > 0 {
+			return nil
+		}
+		if job.Status.Failed > 0 {
+			return fmt.Errorf("verification job %q failed", created.ObjectMeta.Name)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for verification job %q", created.ObjectMeta.Name)
+		}
+		time.Sleep(jobPollInterval)
+	}
+}
+
+// jobNameSafe maps a check name to a string usable in a Job name.
+func jobNameSafe(name string) string {
+	var b []rune
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			b = append(b, r)
+		} else {
+			b = append(b, '-')
+		}
+	}
+	return string(b)
+}
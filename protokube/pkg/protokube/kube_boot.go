@@ -126,6 +126,10 @@ func (k *KubeBoot) syncOnce() error {
 		}
 	}
 
+	if err := ReportNodeDrift(k.Kubernetes, k.InternalIP); err != nil {
+		glog.Warningf("error reporting node configuration drift: %v", err)
+	}
+
 	if k.InitializeRBAC {
 		// TODO: Idempotency
 		if err := InitializeRBAC(k.Kubernetes); err != nil {
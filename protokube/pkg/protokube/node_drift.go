@@ -0,0 +1,190 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protokube
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/pkg/api/v1"
+)
+
+// NodeDriftAnnotation is set on a node when its running sysctls no longer
+// match what nodeup configured; the value lists the drifted settings.
+// `kops validate cluster` surfaces nodes carrying this annotation.
+const NodeDriftAnnotation = "kops.k8s.io/configuration-drift"
+
+// sysctlConfPath is the sysctl file written by nodeup; it is the source of
+// truth for what the node was configured with.
+const sysctlConfPath = "/etc/sysctl.d/99-k8s-general.conf"
+
+// driftNodePatch is a strategic merge patch for the drift annotation; a nil
+// value removes the annotation.
+type driftNodePatch struct {
+	Metadata driftNodePatchMetadata `json:"metadata"`
+}
+
+type driftNodePatchMetadata struct {
+	Annotations map[string]*string `json:"annotations"`
+}
+
+// checkSysctlDrift compares the sysctls nodeup configured against the values
+// the kernel is actually running, and describes each mismatch.  Settings can
+// drift when another agent rewrites them, or silently fail to apply when the
+// kernel module providing them (e.g. br_netfilter) is not loaded.
+func checkSysctlDrift() ([]string, error) {
+	contents, err := ioutil.ReadFile(PathFor(sysctlConfPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// nodeup has not configured sysctls on this node
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading %s: %v", sysctlConfPath, err)
+	}
+
+	var drift []string
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		tokens := strings.SplitN(line, "=", 2)
+		if len(tokens) != 2 {
+			glog.Warningf("ignoring unparseable line in %s: %q", sysctlConfPath, line)
+			continue
+		}
+		key := strings.TrimSpace(tokens[0])
+		expected := strings.Join(strings.Fields(tokens[1]), " ")
+
+		actual, err := readSysctl(key)
+		if err != nil {
+			if os.IsNotExist(err) {
+				drift = append(drift, fmt.Sprintf("sysctl %s is not available (kernel module not loaded?)", key))
+				continue
+			}
+			return nil, fmt.Errorf("error reading sysctl %s: %v", key, err)
+		}
+
+		if actual != expected {
+			drift = append(drift, fmt.Sprintf("sysctl %s is %q, configured %q", key, actual, expected))
+		}
+	}
+
+	return drift, nil
+}
+
+// readSysctl reads the running value of a sysctl from /proc/sys, with
+// whitespace normalized so values like tcp_rmem compare cleanly.
+func readSysctl(key string) (string, error) {
+	p := PathFor("/proc/sys/" + strings.Replace(key, ".", "/", -1))
+	contents, err := ioutil.ReadFile(p)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(strings.Fields(string(contents)), " "), nil
+}
+
+// ReportNodeDrift checks for sysctl drift and records the result on our node
+// object, setting NodeDriftAnnotation when drift is found and clearing it when
+// the node is clean again.
+func ReportNodeDrift(kubeContext *KubernetesContext, internalIP net.IP) error {
+	drift, err := checkSysctlDrift()
+	if err != nil {
+		return err
+	}
+
+	client, err := kubeContext.KubernetesClient()
+	if err != nil {
+		return err
+	}
+
+	node, err := findNodeByInternalIP(client, internalIP)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		glog.V(2).Infof("no node found with internal IP %s; kubelet may not have registered yet", internalIP)
+		return nil
+	}
+
+	var value *string
+	if len(drift) != 0 {
+		s := strings.Join(drift, "; ")
+		value = &s
+	}
+
+	current, found := node.Annotations[NodeDriftAnnotation]
+	if value == nil && !found {
+		return nil
+	}
+	if value != nil && current == *value {
+		return nil
+	}
+
+	patch := &driftNodePatch{
+		Metadata: driftNodePatchMetadata{
+			Annotations: map[string]*string{NodeDriftAnnotation: value},
+		},
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("error building node patch: %v", err)
+	}
+
+	if value != nil {
+		glog.Warningf("node configuration has drifted: %s", *value)
+	} else {
+		glog.Infof("node configuration drift resolved")
+	}
+
+	_, err = client.CoreV1().Nodes().Patch(node.Name, types.StrategicMergePatchType, patchJSON)
+	if err != nil {
+		return fmt.Errorf("error applying patch to node %q: %v", node.Name, err)
+	}
+
+	return nil
+}
+
+// findNodeByInternalIP returns the node whose InternalIP address matches, or
+// nil if none does.  Protokube does not know its node name, but it does know
+// the IP it serves DNS records for.
+func findNodeByInternalIP(client kubernetes.Interface, internalIP net.IP) (*v1.Node, error) {
+	nodes, err := client.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error querying nodes: %v", err)
+	}
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		for _, address := range node.Status.Addresses {
+			if address.Type == v1.NodeInternalIP && address.Address == internalIP.String() {
+				return node, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
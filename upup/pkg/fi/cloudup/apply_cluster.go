@@ -110,6 +110,12 @@ func (c *ApplyClusterCmd) Run() error {
 		c.MaxTaskDuration = DefaultMaxTaskDuration
 	}
 
+	// Feature flags from the spec travel with the cluster definition;
+	// KOPS_FEATURE_FLAGS (already parsed) wins where both are set
+	if err := featureflag.ParseSpecFlags(c.Cluster.Spec.FeatureGates); err != nil {
+		return err
+	}
+
 	if c.InstanceGroups == nil {
 		list, err := c.Clientset.InstanceGroupsFor(c.Cluster).List(metav1.ListOptions{})
 		if err != nil {
@@ -273,6 +279,10 @@ func (c *ApplyClusterCmd) Run() error {
 		return err
 	}
 
+	if err := validateInstanceGroupImages(cloud, c.InstanceGroups); err != nil {
+		return err
+	}
+
 	region := ""
 	project := ""
 
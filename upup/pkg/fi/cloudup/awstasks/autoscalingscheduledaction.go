@@ -0,0 +1,144 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awstasks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/golang/glog"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+)
+
+//go:generate fitask -type=AutoscalingScheduledAction
+type AutoscalingScheduledAction struct {
+	Name *string
+
+	AutoscalingGroup *AutoscalingGroup
+
+	// Recurrence is a cron expression (in UTC) describing when the action runs
+	Recurrence *string
+
+	MinSize         *int64
+	MaxSize         *int64
+	DesiredCapacity *int64
+}
+
+var _ fi.CompareWithID = &AutoscalingScheduledAction{}
+
+func (e *AutoscalingScheduledAction) CompareWithID() *string {
+	return e.Name
+}
+
+func (e *AutoscalingScheduledAction) Find(c *fi.Context) (*AutoscalingScheduledAction, error) {
+	cloud := c.Cloud.(awsup.AWSCloud)
+
+	request := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: e.AutoscalingGroup.Name,
+		ScheduledActionNames: []*string{e.Name},
+	}
+
+	response, err := cloud.Autoscaling().DescribeScheduledActions(request)
+	if err != nil {
+		return nil, fmt.Errorf("error listing scheduled actions: %v", err)
+	}
+	if response == nil || len(response.ScheduledUpdateGroupActions) == 0 {
+		return nil, nil
+	}
+	if len(response.ScheduledUpdateGroupActions) != 1 {
+		return nil, fmt.Errorf("Found multiple scheduled actions with name %q", *e.Name)
+	}
+
+	a := response.ScheduledUpdateGroupActions[0]
+
+	actual := &AutoscalingScheduledAction{
+		Name:            a.ScheduledActionName,
+		Recurrence:      a.Recurrence,
+		MinSize:         a.MinSize,
+		MaxSize:         a.MaxSize,
+		DesiredCapacity: a.DesiredCapacity,
+	}
+
+	// Avoid spurious changes on the parent ASG
+	actual.AutoscalingGroup = e.AutoscalingGroup
+
+	return actual, nil
+}
+
+func (e *AutoscalingScheduledAction) Run(c *fi.Context) error {
+	return fi.DefaultDeltaRunMethod(e, c)
+}
+
+func (s *AutoscalingScheduledAction) CheckChanges(a, e, changes *AutoscalingScheduledAction) error {
+	if a == nil {
+		if e.Name == nil {
+			return fi.RequiredField("Name")
+		}
+		if e.AutoscalingGroup == nil {
+			return fi.RequiredField("AutoscalingGroup")
+		}
+		if e.Recurrence == nil {
+			return fi.RequiredField("Recurrence")
+		}
+	}
+	return nil
+}
+
+func (_ *AutoscalingScheduledAction) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *AutoscalingScheduledAction) error {
+	// PutScheduledUpdateGroupAction is an upsert, so create and update are the same call
+	glog.V(2).Infof("Putting autoscaling scheduled action %q", *e.Name)
+
+	request := &autoscaling.PutScheduledUpdateGroupActionInput{
+		AutoScalingGroupName: e.AutoscalingGroup.Name,
+		ScheduledActionName:  e.Name,
+		Recurrence:           e.Recurrence,
+		MinSize:              e.MinSize,
+		MaxSize:              e.MaxSize,
+		DesiredCapacity:      e.DesiredCapacity,
+	}
+
+	_, err := t.Cloud.Autoscaling().PutScheduledUpdateGroupAction(request)
+	if err != nil {
+		return fmt.Errorf("error creating scheduled action: %v", err)
+	}
+
+	return nil
+}
+
+type terraformAutoscalingSchedule struct {
+	ScheduledActionName  *string            `json:"scheduled_action_name"`
+	AutoscalingGroupName *terraform.Literal `json:"autoscaling_group_name"`
+	Recurrence           *string            `json:"recurrence"`
+	MinSize              *int64             `json:"min_size,omitempty"`
+	MaxSize              *int64             `json:"max_size,omitempty"`
+	DesiredCapacity      *int64             `json:"desired_capacity,omitempty"`
+}
+
+func (_ *AutoscalingScheduledAction) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *AutoscalingScheduledAction) error {
+	tf := &terraformAutoscalingSchedule{
+		ScheduledActionName:  e.Name,
+		AutoscalingGroupName: e.AutoscalingGroup.TerraformLink(),
+		Recurrence:           e.Recurrence,
+		MinSize:              e.MinSize,
+		MaxSize:              e.MaxSize,
+		DesiredCapacity:      e.DesiredCapacity,
+	}
+
+	return t.RenderResource("aws_autoscaling_schedule", *e.Name, tf)
+}
@@ -34,6 +34,9 @@ import (
 type IAMInstanceProfile struct {
 	Name *string
 	ID   *string
+
+	// Shared is set if this is an adopted instance profile (one we don't create or own)
+	Shared *bool
 }
 
 var _ fi.CompareWithID = &IAMInstanceProfile{}
@@ -78,6 +81,9 @@ func (e *IAMInstanceProfile) Find(c *fi.Context) (*IAMInstanceProfile, error) {
 		Name: p.InstanceProfileName,
 	}
 
+	// Prevent spurious comparison failures
+	actual.Shared = e.Shared
+
 	e.ID = actual.ID
 	e.Name = actual.Name
 
@@ -88,6 +94,13 @@ func (e *IAMInstanceProfile) Run(c *fi.Context) error {
 	return fi.DefaultDeltaRunMethod(e, c)
 }
 
+func (_ *IAMInstanceProfile) ShouldCreate(a, e, changes *IAMInstanceProfile) (bool, error) {
+	if fi.BoolValue(e.Shared) {
+		return false, nil
+	}
+	return true, nil
+}
+
 func (s *IAMInstanceProfile) CheckChanges(a, e, changes *IAMInstanceProfile) error {
 	if a != nil {
 		if fi.StringValue(e.Name) == "" {
@@ -98,6 +111,14 @@ func (s *IAMInstanceProfile) CheckChanges(a, e, changes *IAMInstanceProfile) err
 }
 
 func (_ *IAMInstanceProfile) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *IAMInstanceProfile) error {
+	if fi.BoolValue(e.Shared) {
+		// Adopted instance profile; we don't create or modify it, but it must exist
+		if a == nil {
+			return fmt.Errorf("adopted IAMInstanceProfile %q not found", fi.StringValue(e.Name))
+		}
+		return nil
+	}
+
 	if a == nil {
 		glog.V(2).Infof("Creating IAMInstanceProfile with Name:%q", *e.Name)
 
@@ -64,6 +64,10 @@ type LoadBalancer struct {
 	ConnectionDraining     *LoadBalancerConnectionDraining
 	ConnectionSettings     *LoadBalancerConnectionSettings
 	CrossZoneLoadBalancing *LoadBalancerCrossZoneLoadBalancing
+
+	// Shared is set if this is an adopted load balancer (one we don't create or own);
+	// it is found by LoadBalancerName, because it won't carry our Name tag
+	Shared *bool
 }
 
 var _ fi.CompareWithID = &LoadBalancer{}
@@ -271,7 +275,13 @@ func describeLoadBalancerTags(cloud awsup.AWSCloud, loadBalancerNames []string)
 func (e *LoadBalancer) Find(c *fi.Context) (*LoadBalancer, error) {
 	cloud := c.Cloud.(awsup.AWSCloud)
 
-	lb, err := FindLoadBalancerByNameTag(cloud, fi.StringValue(e.Name))
+	var lb *elb.LoadBalancerDescription
+	var err error
+	if fi.BoolValue(e.Shared) {
+		lb, err = findLoadBalancerByLoadBalancerName(cloud, fi.StringValue(e.LoadBalancerName))
+	} else {
+		lb, err = FindLoadBalancerByNameTag(cloud, fi.StringValue(e.Name))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -361,6 +371,7 @@ func (e *LoadBalancer) Find(c *fi.Context) (*LoadBalancer, error) {
 	}
 
 	// Avoid spurious mismatches
+	actual.Shared = e.Shared
 	if subnetSlicesEqualIgnoreOrder(actual.Subnets, e.Subnets) {
 		actual.Subnets = e.Subnets
 	}
@@ -408,6 +419,13 @@ func (e *LoadBalancer) FindIPAddress(context *fi.Context) (*string, error) {
 	return &lbDnsName, nil
 }
 
+func (_ *LoadBalancer) ShouldCreate(a, e, changes *LoadBalancer) (bool, error) {
+	if fi.BoolValue(e.Shared) {
+		return false, nil
+	}
+	return true, nil
+}
+
 func (e *LoadBalancer) Run(c *fi.Context) error {
 	// TODO: Make Normalize a standard method
 	e.Normalize()
@@ -464,6 +482,14 @@ func (s *LoadBalancer) CheckChanges(a, e, changes *LoadBalancer) error {
 }
 
 func (_ *LoadBalancer) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *LoadBalancer) error {
+	if fi.BoolValue(e.Shared) {
+		// Adopted load balancer; we don't create or modify it, but it must exist
+		if a == nil {
+			return fmt.Errorf("adopted LoadBalancer %q not found", fi.StringValue(e.LoadBalancerName))
+		}
+		return nil
+	}
+
 	var loadBalancerName string
 	if a == nil {
 		if e.LoadBalancerName == nil {
@@ -618,6 +644,11 @@ type terraformLoadBalancerHealthCheck struct {
 }
 
 func (_ *LoadBalancer) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *LoadBalancer) error {
+	if fi.BoolValue(e.Shared) {
+		// Not terraform owned / managed
+		return nil
+	}
+
 	cloud := t.Cloud.(awsup.AWSCloud)
 
 	if e.LoadBalancerName == nil {
@@ -741,6 +772,11 @@ type cloudformationConnectionSettings struct {
 }
 
 func (_ *LoadBalancer) RenderCloudformation(t *cloudformation.CloudformationTarget, a, e, changes *LoadBalancer) error {
+	if fi.BoolValue(e.Shared) {
+		// Not cloudformation owned / managed
+		return nil
+	}
+
 	// TODO: From http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-ec2-elb.html:
 	// If this resource has a public IP address and is also in a VPC that is defined in the same template,
 	// you must use the DependsOn attribute to declare a dependency on the VPC-gateway attachment.
@@ -178,6 +178,14 @@ func (e *SecurityGroupRule) Run(c *fi.Context) error {
 	return fi.DefaultDeltaRunMethod(e, c)
 }
 
+func (_ *SecurityGroupRule) ShouldCreate(a, e, changes *SecurityGroupRule) (bool, error) {
+	// We never add rules to an adopted security group; its owner manages them
+	if e.SecurityGroup != nil && fi.BoolValue(e.SecurityGroup.Shared) {
+		return false, nil
+	}
+	return true, nil
+}
+
 func (_ *SecurityGroupRule) CheckChanges(a, e, changes *SecurityGroupRule) error {
 	if a == nil {
 		if e.SecurityGroup == nil {
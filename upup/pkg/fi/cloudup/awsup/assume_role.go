@@ -0,0 +1,58 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/glog"
+)
+
+// AssumeRoleOptions describes an elevated role to assume for mutating operations,
+// for organizations that require a separate role (often with an external id and
+// MFA) to make changes while reads run on the base credentials
+type AssumeRoleOptions struct {
+	// RoleARN is the ARN of the role to assume
+	RoleARN string
+	// ExternalID is the external id to pass to AssumeRole, if the role requires one
+	ExternalID string
+	// MFASerial is the serial number / ARN of the MFA device, if the role requires MFA
+	MFASerial string
+	// MFAToken is the current one-time code from the MFA device
+	MFAToken string
+}
+
+// assumeRole, when set, applies to all AWS clients built afterwards
+var assumeRole *AssumeRoleOptions
+
+// ConfigureAssumeRole arranges for subsequently built AWS clients to assume the
+// given role.  Commands call this just before their first mutating cloud
+// operation, so read-only work keeps using the base credentials.
+func ConfigureAssumeRole(options *AssumeRoleOptions) {
+	glog.V(2).Infof("will assume role %q for mutating operations", options.RoleARN)
+	assumeRole = options
+
+	// Drop cached clients that were built with the base credentials
+	awsCloudInstances = make(map[string]AWSCloud)
+}
+
+// externalID returns the external id as the SDK wants it, or nil if unset
+func (a *AssumeRoleOptions) externalID() *string {
+	if a.ExternalID == "" {
+		return nil
+	}
+	return aws.String(a.ExternalID)
+}
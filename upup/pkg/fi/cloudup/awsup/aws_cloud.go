@@ -20,6 +20,9 @@ import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
@@ -30,8 +33,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/golang/glog"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/readonly"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kubernetes/federation/pkg/dnsprovider"
 	k8sroute53 "k8s.io/kubernetes/federation/pkg/dnsprovider/providers/aws/route53"
@@ -137,6 +142,26 @@ func (c *awsCloudImplementation) ProviderID() kops.CloudProviderID {
 	return kops.CloudProviderAWS
 }
 
+// rejectMutatingOperations is a request handler that fails any AWS call that
+// is not a read, implementing read-only mode at the client layer
+func rejectMutatingOperations(r *request.Request) {
+	if isReadOnlyAWSOperation(r.Operation.Name) {
+		return
+	}
+	r.Error = readonly.Error(fmt.Sprintf("call %s %s", r.ClientInfo.ServiceName, r.Operation.Name))
+}
+
+// isReadOnlyAWSOperation classifies AWS API operations by name; the AWS APIs
+// consistently use these prefixes for calls that do not change anything
+func isReadOnlyAWSOperation(name string) bool {
+	for _, prefix := range []string{"Describe", "List", "Get"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *awsCloudImplementation) Region() string {
 	return c.region
 }
@@ -159,24 +184,73 @@ func NewAWSCloud(region string, tags map[string]string) (AWSCloud, error) {
 
 		requestLogger := newRequestLogger(2)
 
-		c.cf = cloudformation.New(session.New(), config)
+		// A single session shared by all clients, with the shared config
+		// (~/.aws/config) enabled: this makes profiles that assume a role
+		// work without AWS_SDK_LOAD_CONFIG, and role credentials obtained
+		// through STS are refreshed automatically before they expire, so a
+		// long rolling update does not fail partway through
+		sess, err := session.NewSessionWithOptions(session.Options{
+			SharedConfigState: session.SharedConfigEnable,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error starting AWS session: %v", err)
+		}
+
+		if readonly.Enabled() {
+			// Reject mutations in the SDK itself, so --read-only holds even if
+			// a code path unexpectedly tries to make a change
+			sess.Handlers.Validate.PushFront(rejectMutatingOperations)
+		}
+
+		if assumeRole != nil {
+			provider := &stscreds.AssumeRoleProvider{
+				Client:     sts.New(sess, config),
+				RoleARN:    assumeRole.RoleARN,
+				ExternalID: assumeRole.externalID(),
+				Duration:   stscreds.DefaultDuration,
+			}
+			if assumeRole.MFASerial != "" {
+				provider.SerialNumber = aws.String(assumeRole.MFASerial)
+				provider.TokenCode = aws.String(assumeRole.MFAToken)
+			}
+			config = config.WithCredentials(credentials.NewCredentials(provider))
+		}
+
+		c.cf = cloudformation.New(sess, config)
 		c.cf.Handlers.Send.PushFront(requestLogger)
 
-		c.ec2 = ec2.New(session.New(), config)
+		c.ec2 = ec2.New(sess, config)
 		c.ec2.Handlers.Send.PushFront(requestLogger)
 
-		c.iam = iam.New(session.New(), config)
+		c.iam = iam.New(sess, config)
 		c.iam.Handlers.Send.PushFront(requestLogger)
 
-		c.elb = elb.New(session.New(), config)
+		c.elb = elb.New(sess, config)
 		c.elb.Handlers.Send.PushFront(requestLogger)
 
-		c.autoscaling = autoscaling.New(session.New(), config)
+		c.autoscaling = autoscaling.New(sess, config)
 		c.autoscaling.Handlers.Send.PushFront(requestLogger)
 
-		c.route53 = route53.New(session.New(), config)
+		c.route53 = route53.New(sess, config)
 		c.route53.Handlers.Send.PushFront(requestLogger)
 
+		// Tag and security group mutations are throttled by AWS per account,
+		// so the token bucket is shared by account+region; concurrent update
+		// cluster runs in one process then stay under the account limit.
+		// The account id is looked up lazily, on the first mutating call.
+		rateLimitHandler := &mutationRateLimitHandler{
+			region: region,
+			accountID: func() (string, error) {
+				identity, err := sts.New(sess, config).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+				if err != nil {
+					return "", err
+				}
+				return aws.StringValue(identity.Account), nil
+			},
+		}
+		c.ec2.Handlers.Send.PushFront(rateLimitHandler.requestHandler)
+		c.elb.Handlers.Send.PushFront(rateLimitHandler.requestHandler)
+
 		awsCloudInstances[region] = c
 		raw = c
 	}
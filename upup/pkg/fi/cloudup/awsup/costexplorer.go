@@ -0,0 +1,156 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+// The vendored aws-sdk-go predates the Cost Explorer service, so the one
+// operation we need is defined by hand on top of the SDK core, following the
+// shape of the generated service clients.  This can be dropped on the next
+// aws-sdk-go vendor update.
+
+// costExplorerEndpoint is the Cost Explorer endpoint; the service only
+// exists in us-east-1
+const costExplorerEndpoint = "https://ce.us-east-1.amazonaws.com"
+
+// CostExplorer is a client for the AWS Cost Explorer service
+type CostExplorer struct {
+	*client.Client
+}
+
+// NewCostExplorer creates a CostExplorer client, using the same credential
+// chain as the other kops AWS clients
+func NewCostExplorer() (*CostExplorer, error) {
+	config := aws.NewConfig().WithRegion("us-east-1").WithEndpoint(costExplorerEndpoint)
+	config = config.WithCredentialsChainVerboseErrors(true)
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error starting AWS session: %v", err)
+	}
+
+	c := sess.ClientConfig("ce", config)
+	svc := &CostExplorer{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   "ce",
+				SigningName:   "ce",
+				SigningRegion: "us-east-1",
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2017-10-25",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "AWSInsightsIndexService",
+			},
+			c.Handlers,
+		),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
+
+	return svc, nil
+}
+
+// DateInterval is a Cost Explorer time period; dates are YYYY-MM-DD, and End is exclusive
+type DateInterval struct {
+	Start *string
+	End   *string
+}
+
+// GroupDefinition requests grouping of results, e.g. by a cost allocation tag
+type GroupDefinition struct {
+	Type *string
+	Key  *string
+}
+
+// TagValues matches resources carrying one of the values for a tag key
+type TagValues struct {
+	Key    *string
+	Values []*string
+}
+
+// Expression is a Cost Explorer filter expression
+type Expression struct {
+	And  []*Expression
+	Tags *TagValues
+}
+
+// GetCostAndUsageInput is the input to GetCostAndUsage
+type GetCostAndUsageInput struct {
+	TimePeriod    *DateInterval
+	Granularity   *string
+	Filter        *Expression
+	Metrics       []*string
+	GroupBy       []*GroupDefinition
+	NextPageToken *string
+}
+
+// MetricValue is one metric amount, e.g. {"123.45", "USD"}
+type MetricValue struct {
+	Amount *string
+	Unit   *string
+}
+
+// Group is the costs for one group key, e.g. one tag value
+type Group struct {
+	Keys    []*string
+	Metrics map[string]*MetricValue
+}
+
+// ResultByTime is the results for one time period
+type ResultByTime struct {
+	TimePeriod *DateInterval
+	Total      map[string]*MetricValue
+	Groups     []*Group
+	Estimated  *bool
+}
+
+// GetCostAndUsageOutput is the output of GetCostAndUsage
+type GetCostAndUsageOutput struct {
+	GroupDefinitions []*GroupDefinition
+	ResultsByTime    []*ResultByTime
+	NextPageToken    *string
+}
+
+// GetCostAndUsage queries actual costs from Cost Explorer
+func (c *CostExplorer) GetCostAndUsage(input *GetCostAndUsageInput) (*GetCostAndUsageOutput, error) {
+	op := &request.Operation{
+		Name:       "GetCostAndUsage",
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	output := &GetCostAndUsageOutput{}
+	req := c.NewRequest(op, input, output)
+	return output, req.Send()
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"testing"
+)
+
+func TestIsReadOnlyAWSOperation(t *testing.T) {
+	grid := []struct {
+		Operation string
+		ReadOnly  bool
+	}{
+		{"DescribeInstances", true},
+		{"ListHostedZones", true},
+		{"GetConsoleOutput", true},
+		{"RunInstances", false},
+		{"CreateTags", false},
+		{"DeleteLoadBalancer", false},
+		{"UpdateAutoScalingGroup", false},
+		{"TerminateInstanceInAutoScalingGroup", false},
+	}
+
+	for _, g := range grid {
+		actual := isReadOnlyAWSOperation(g.Operation)
+		if actual != g.ReadOnly {
+			t.Errorf("isReadOnlyAWSOperation(%q) = %v, expected %v", g.Operation, actual, g.ReadOnly)
+		}
+	}
+}
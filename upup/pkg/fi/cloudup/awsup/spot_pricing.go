@@ -0,0 +1,95 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// spotProductDescription is the product we price against; kops launches Linux instances in a VPC
+const spotProductDescription = "Linux/UNIX (Amazon VPC)"
+
+// CheckSpotPricing queries recent spot price history for the instance type and returns
+// a warning for each of the given zones where the current spot price is at or above
+// the bid, so capacity problems surface before instances fail to launch.
+func CheckSpotPricing(cloud AWSCloud, instanceType string, maxPrice string, zones []string) ([]string, error) {
+	bid, err := strconv.ParseFloat(maxPrice, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing max price %q: %v", maxPrice, err)
+	}
+
+	request := &ec2.DescribeSpotPriceHistoryInput{
+		InstanceTypes:       []*string{aws.String(instanceType)},
+		ProductDescriptions: []*string{aws.String(spotProductDescription)},
+		StartTime:           aws.Time(time.Now().Add(-1 * time.Hour)),
+	}
+
+	response, err := cloud.EC2().DescribeSpotPriceHistory(request)
+	if err != nil {
+		return nil, fmt.Errorf("error querying spot price history: %v", err)
+	}
+
+	// Keep the most recent price per zone
+	latestPrice := make(map[string]float64)
+	latestTimestamp := make(map[string]time.Time)
+	for _, p := range response.SpotPriceHistory {
+		if p.AvailabilityZone == nil || p.SpotPrice == nil || p.Timestamp == nil {
+			continue
+		}
+		price, err := strconv.ParseFloat(*p.SpotPrice, 64)
+		if err != nil {
+			continue
+		}
+		zone := *p.AvailabilityZone
+		if p.Timestamp.After(latestTimestamp[zone]) {
+			latestTimestamp[zone] = *p.Timestamp
+			latestPrice[zone] = price
+		}
+	}
+
+	// Find the cheapest zone to suggest as an alternative
+	cheapestZone := ""
+	cheapestPrice := 0.0
+	for zone, price := range latestPrice {
+		if cheapestZone == "" || price < cheapestPrice {
+			cheapestZone = zone
+			cheapestPrice = price
+		}
+	}
+
+	var warnings []string
+	for _, zone := range zones {
+		price, ok := latestPrice[zone]
+		if !ok {
+			continue
+		}
+		if price >= bid {
+			w := fmt.Sprintf("current spot price for %s in %s is %.4f, at or above the bid of %.4f; instances may not launch", instanceType, zone, price, bid)
+			if cheapestZone != "" && cheapestZone != zone && cheapestPrice < bid {
+				w += fmt.Sprintf(" (cheapest zone is %s at %.4f)", cheapestZone, cheapestPrice)
+			}
+			warnings = append(warnings, w)
+		}
+	}
+
+	return warnings, nil
+}
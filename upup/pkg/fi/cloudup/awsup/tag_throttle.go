@@ -0,0 +1,162 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/golang/glog"
+)
+
+// AWS throttles tag and security group mutations per account, not per
+// cluster.  Updating many clusters in one account concurrently (fleet
+// pipelines) can trip that limit even though each individual run is well
+// behaved, so these mutations share a token bucket keyed by account+region.
+const (
+	// MutationRateLimitQPS is the default sustained rate of tag and security
+	// group mutations per account+region
+	MutationRateLimitQPS = 5.0
+	// MutationRateLimitBurst is the number of mutations allowed to run at
+	// full speed before the sustained rate applies; a single small cluster
+	// should normally fit in the burst and never wait
+	MutationRateLimitBurst = 50.0
+)
+
+// MutationRateLimitEnvVar overrides the sustained mutation rate (a float,
+// in requests per second); 0 disables the rate limit
+const MutationRateLimitEnvVar = "KOPS_AWS_MUTATION_RATE_LIMIT"
+
+// mutationRateLimiter is a token bucket: each mutation takes a token, and
+// tokens refill at a fixed rate up to the burst size
+type mutationRateLimiter struct {
+	mutex      sync.Mutex
+	qps        float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+var mutationRateLimitersMutex sync.Mutex
+var mutationRateLimiters = make(map[string]*mutationRateLimiter)
+
+// mutationRateLimiterFor returns the shared limiter for the key (normally
+// account+region), creating it on first use.  A nil limiter means rate
+// limiting is disabled.
+func mutationRateLimiterFor(key string) *mutationRateLimiter {
+	qps := MutationRateLimitQPS
+	if env := os.Getenv(MutationRateLimitEnvVar); env != "" {
+		parsed, err := strconv.ParseFloat(env, 64)
+		if err != nil || parsed < 0 {
+			glog.Warningf("ignoring invalid %s=%q", MutationRateLimitEnvVar, env)
+		} else {
+			qps = parsed
+		}
+	}
+	if qps == 0 {
+		return nil
+	}
+
+	mutationRateLimitersMutex.Lock()
+	defer mutationRateLimitersMutex.Unlock()
+
+	l := mutationRateLimiters[key]
+	if l == nil {
+		l = &mutationRateLimiter{
+			qps:        qps,
+			burst:      MutationRateLimitBurst,
+			tokens:     MutationRateLimitBurst,
+			lastRefill: time.Now(),
+		}
+		mutationRateLimiters[key] = l
+	}
+	return l
+}
+
+// wait returns how long the caller must sleep before its mutation may run,
+// taking a token (possibly a future one) from the bucket
+func (l *mutationRateLimiter) wait(now time.Time) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	elapsed := now.Sub(l.lastRefill)
+	l.lastRefill = now
+	l.tokens += elapsed.Seconds() * l.qps
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.qps * float64(time.Second))
+}
+
+// acquire blocks until the mutation may run
+func (l *mutationRateLimiter) acquire(operation string) {
+	d := l.wait(time.Now())
+	if d == 0 {
+		return
+	}
+	glog.V(2).Infof("rate limiting %s: waiting %v", operation, d)
+	time.Sleep(d)
+}
+
+// mutationRateLimitHandler is an aws-sdk-go request handler applying the rate
+// limit to every rate-limited operation, whichever code path issues it.  The
+// account id (and so the shared limiter) is resolved on the first mutating
+// call, so that constructing the cloud never blocks on STS.
+type mutationRateLimitHandler struct {
+	region    string
+	accountID func() (string, error)
+
+	once    sync.Once
+	limiter *mutationRateLimiter
+}
+
+func (h *mutationRateLimitHandler) requestHandler(r *request.Request) {
+	if !isRateLimitedAWSOperation(r.Operation.Name) {
+		return
+	}
+	h.once.Do(func() {
+		accountID, err := h.accountID()
+		if err != nil {
+			glog.Warningf("error getting AWS account id; rate limiting mutations per region only: %v", err)
+			accountID = ""
+		}
+		h.limiter = mutationRateLimiterFor(accountID + "/" + h.region)
+	})
+	if h.limiter != nil {
+		h.limiter.acquire(r.Operation.Name)
+	}
+}
+
+// isRateLimitedAWSOperation classifies the high-volume tag and security group
+// mutations that reconciliation issues once per resource; other mutations are
+// rare enough to leave alone
+func isRateLimitedAWSOperation(name string) bool {
+	switch name {
+	case "CreateTags", "DeleteTags", "AddTags", "RemoveTags":
+		return true
+	}
+	return strings.HasPrefix(name, "AuthorizeSecurityGroup") || strings.HasPrefix(name, "RevokeSecurityGroup")
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestIsRateLimitedAWSOperation(t *testing.T) {
+	grid := []struct {
+		Operation   string
+		RateLimited bool
+	}{
+		{"CreateTags", true},
+		{"DeleteTags", true},
+		{"AddTags", true},
+		{"AuthorizeSecurityGroupIngress", true},
+		{"RevokeSecurityGroupEgress", true},
+		{"DescribeTags", false},
+		{"DescribeInstances", false},
+		{"RunInstances", false},
+		{"CreateSecurityGroup", false},
+	}
+
+	for _, g := range grid {
+		actual := isRateLimitedAWSOperation(g.Operation)
+		if actual != g.RateLimited {
+			t.Errorf("isRateLimitedAWSOperation(%q) = %v, expected %v", g.Operation, actual, g.RateLimited)
+		}
+	}
+}
+
+func TestMutationRateLimiter(t *testing.T) {
+	now := time.Now()
+	l := &mutationRateLimiter{
+		qps:        2,
+		burst:      2,
+		tokens:     2,
+		lastRefill: now,
+	}
+
+	// The burst runs at full speed
+	if d := l.wait(now); d != 0 {
+		t.Errorf("first call should not wait, got %v", d)
+	}
+	if d := l.wait(now); d != 0 {
+		t.Errorf("second call should not wait, got %v", d)
+	}
+
+	// The bucket is empty; at 2 qps the next token is 500ms away
+	if d := l.wait(now); d != 500*time.Millisecond {
+		t.Errorf("third call should wait 500ms, got %v", d)
+	}
+
+	// After a quiet second the bucket has refilled (up to the burst size)
+	now = now.Add(3 * time.Second)
+	if d := l.wait(now); d != 0 {
+		t.Errorf("call after refill should not wait, got %v", d)
+	}
+}
+
+func TestMutationRateLimitHandlerLazyAccountLookup(t *testing.T) {
+	lookups := 0
+	h := &mutationRateLimitHandler{
+		region: "us-test-1",
+		accountID: func() (string, error) {
+			lookups++
+			return "123456789012", nil
+		},
+	}
+
+	h.requestHandler(&request.Request{Operation: &request.Operation{Name: "DescribeTags"}})
+	if lookups != 0 {
+		t.Errorf("read-only operation should not resolve the account id, got %d lookups", lookups)
+	}
+
+	h.requestHandler(&request.Request{Operation: &request.Operation{Name: "CreateTags"}})
+	h.requestHandler(&request.Request{Operation: &request.Operation{Name: "DeleteTags"}})
+	if lookups != 1 {
+		t.Errorf("account id should be resolved exactly once, got %d lookups", lookups)
+	}
+}
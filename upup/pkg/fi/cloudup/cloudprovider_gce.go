@@ -0,0 +1,66 @@
+// +build !nocloudgce
+
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+func init() {
+	RegisterCloudProvider("gce", buildGCECloud)
+}
+
+func buildGCECloud(cluster *kops.Cluster) (fi.Cloud, error) {
+	region := ""
+
+	nodeZones := make(map[string]bool)
+	for _, subnet := range cluster.Spec.Subnets {
+		nodeZones[subnet.Zone] = true
+
+		tokens := strings.Split(subnet.Zone, "-")
+		if len(tokens) <= 2 {
+			return nil, fmt.Errorf("Invalid GCE Zone: %v", subnet.Zone)
+		}
+		zoneRegion := tokens[0] + "-" + tokens[1]
+		if region != "" && zoneRegion != region {
+			return nil, fmt.Errorf("Clusters cannot span multiple regions (found zone %q, but region is %q)", subnet.Zone, region)
+		}
+
+		region = zoneRegion
+	}
+
+	project := cluster.Spec.Project
+	if project == "" {
+		return nil, fmt.Errorf("project is required for GCE - try gcloud config get-value project")
+	}
+
+	labels := map[string]string{gce.GceLabelNameKubernetesCluster: gce.SafeClusterName(cluster.ObjectMeta.Name)}
+
+	gceCloud, err := gce.NewGCECloud(region, project, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return gceCloud, nil
+}
@@ -0,0 +1,37 @@
+// +build !nocloudvsphere
+
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/vsphere"
+)
+
+func init() {
+	RegisterCloudProvider("vsphere", buildVSphereCloud)
+}
+
+func buildVSphereCloud(cluster *kops.Cluster) (fi.Cloud, error) {
+	vsphereCloud, err := vsphere.NewVSphereCloud(&cluster.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return vsphereCloud, nil
+}
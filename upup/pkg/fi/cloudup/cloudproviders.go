@@ -0,0 +1,82 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// CloudProviderAllowlistEnvVar restricts which cloud providers may be used at
+// runtime; it holds a comma-separated list of provider names, and an empty or
+// unset value allows all compiled-in providers
+const CloudProviderAllowlistEnvVar = "KOPS_CLOUD_PROVIDERS"
+
+// cloudBuilders maps a cloud provider name to its constructor.  Providers
+// register themselves from init() in per-provider files, which can be excluded
+// from the build with tags (e.g. nocloudgce), for slim per-provider binaries.
+var cloudBuilders = make(map[string]func(cluster *kops.Cluster) (fi.Cloud, error))
+
+func RegisterCloudProvider(name string, builder func(cluster *kops.Cluster) (fi.Cloud, error)) {
+	if cloudBuilders[name] != nil {
+		panic(fmt.Sprintf("cloud provider %q registered twice", name))
+	}
+	cloudBuilders[name] = builder
+}
+
+// registeredCloudProviders returns the names of the compiled-in cloud providers
+func registeredCloudProviders() []string {
+	var names []string
+	for name := range cloudBuilders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func cloudProviderAllowed(name string) bool {
+	allowlist := os.Getenv(CloudProviderAllowlistEnvVar)
+	if allowlist == "" {
+		return true
+	}
+	for _, allowed := range strings.Split(allowlist, ",") {
+		if strings.TrimSpace(allowed) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func BuildCloud(cluster *kops.Cluster) (fi.Cloud, error) {
+	name := cluster.Spec.CloudProvider
+
+	builder := cloudBuilders[name]
+	if builder == nil {
+		return nil, fmt.Errorf("unknown CloudProvider %q (compiled-in providers: %s)", name, strings.Join(registeredCloudProviders(), ", "))
+	}
+
+	if !cloudProviderAllowed(name) {
+		return nil, fmt.Errorf("CloudProvider %q is not allowed by %s=%q", name, CloudProviderAllowlistEnvVar, os.Getenv(CloudProviderAllowlistEnvVar))
+	}
+
+	return builder(cluster)
+}
@@ -52,6 +52,10 @@ type populateClusterSpec struct {
 
 	// fullCluster holds the built completed cluster spec
 	fullCluster *api.Cluster
+
+	// attribution, when non-nil, records which options template or builder
+	// set each completed-spec field
+	attribution map[string]string
 }
 
 func findModelStore() (vfs.Path, error) {
@@ -79,6 +83,28 @@ func PopulateClusterSpec(cluster *api.Cluster) (*api.Cluster, error) {
 	return c.fullCluster, nil
 }
 
+// PopulateClusterSpecWithAttribution is PopulateClusterSpec, additionally
+// reporting which options template or builder set each completed-spec field;
+// fields taken unchanged from the user's cluster spec have no entry.
+func PopulateClusterSpecWithAttribution(cluster *api.Cluster) (*api.Cluster, map[string]string, error) {
+	modelStore, err := findModelStore()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &populateClusterSpec{
+		InputCluster: cluster,
+		ModelStore:   modelStore,
+		Models:       []string{"config"},
+		attribution:  make(map[string]string),
+	}
+	err = c.run()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.fullCluster, c.attribution, nil
+}
+
 //
 // Here be dragons
 //
@@ -304,6 +330,7 @@ func (c *populateClusterSpec) run() error {
 		OptionsLoader: loader.NewOptionsLoader(templateFunctions, codeModels),
 		Tags:          tags,
 	}
+	specBuilder.OptionsLoader.Attribution = c.attribution
 
 	completed, err := specBuilder.BuildCompleteSpec(&cluster.Spec, c.ModelStore, fileModels)
 	if err != nil {
@@ -21,98 +21,11 @@ import (
 	"github.com/golang/glog"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/upup/pkg/fi"
-	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
-	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
-	"k8s.io/kops/upup/pkg/fi/cloudup/vsphere"
 	"k8s.io/kubernetes/federation/pkg/dnsprovider"
 	"k8s.io/kubernetes/federation/pkg/dnsprovider/providers/aws/route53"
 	"strings"
 )
 
-func BuildCloud(cluster *kops.Cluster) (fi.Cloud, error) {
-	var cloud fi.Cloud
-
-	region := ""
-	project := ""
-
-	switch cluster.Spec.CloudProvider {
-	case "gce":
-		{
-			nodeZones := make(map[string]bool)
-			for _, subnet := range cluster.Spec.Subnets {
-				nodeZones[subnet.Zone] = true
-
-				tokens := strings.Split(subnet.Zone, "-")
-				if len(tokens) <= 2 {
-					return nil, fmt.Errorf("Invalid GCE Zone: %v", subnet.Zone)
-				}
-				zoneRegion := tokens[0] + "-" + tokens[1]
-				if region != "" && zoneRegion != region {
-					return nil, fmt.Errorf("Clusters cannot span multiple regions (found zone %q, but region is %q)", subnet.Zone, region)
-				}
-
-				region = zoneRegion
-			}
-
-			project = cluster.Spec.Project
-			if project == "" {
-				return nil, fmt.Errorf("project is required for GCE - try gcloud config get-value project")
-			}
-
-			labels := map[string]string{gce.GceLabelNameKubernetesCluster: gce.SafeClusterName(cluster.ObjectMeta.Name)}
-
-			gceCloud, err := gce.NewGCECloud(region, project, labels)
-			if err != nil {
-				return nil, err
-			}
-
-			cloud = gceCloud
-		}
-
-	case "aws":
-		{
-			region, err := awsup.FindRegion(cluster)
-			if err != nil {
-				return nil, err
-			}
-
-			err = awsup.ValidateRegion(region)
-			if err != nil {
-				return nil, err
-			}
-
-			cloudTags := map[string]string{awsup.TagClusterName: cluster.ObjectMeta.Name}
-
-			awsCloud, err := awsup.NewAWSCloud(region, cloudTags)
-			if err != nil {
-				return nil, err
-			}
-
-			var zoneNames []string
-			for _, subnet := range cluster.Spec.Subnets {
-				zoneNames = append(zoneNames, subnet.Zone)
-			}
-			err = awsup.ValidateZones(zoneNames, awsCloud)
-			if err != nil {
-				return nil, err
-			}
-			cloud = awsCloud
-		}
-	case "vsphere":
-		{
-			vsphereCloud, err := vsphere.NewVSphereCloud(&cluster.Spec)
-			if err != nil {
-				return nil, err
-			}
-			cloud = vsphereCloud
-		}
-
-	default:
-		return nil, fmt.Errorf("unknown CloudProvider %q", cluster.Spec.CloudProvider)
-	}
-	return cloud, nil
-}
-
 func FindDNSHostedZone(dns dnsprovider.Interface, clusterDNSName string, dnsType kops.DNSType) (string, error) {
 	glog.V(2).Infof("Querying for all DNS zones to find match for %q", clusterDNSName)
 
@@ -0,0 +1,87 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/glog"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// validateInstanceGroupImages is a preflight check on the images the instance
+// groups reference: a bad image otherwise surfaces only as nodes that boot but
+// never join the cluster.  The image metadata is inspected; the image is not
+// booted.
+func validateInstanceGroupImages(cloud fi.Cloud, instanceGroups []*kops.InstanceGroup) error {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return nil
+	}
+
+	// Instance groups typically share an image; resolve each one once
+	checked := make(map[string]error)
+	for _, ig := range instanceGroups {
+		imageName := ig.Spec.Image
+		if imageName == "" {
+			continue
+		}
+
+		err, found := checked[imageName]
+		if !found {
+			err = validateAWSImage(awsCloud, imageName)
+			checked[imageName] = err
+		}
+		if err != nil {
+			return fmt.Errorf("InstanceGroup %q: %v", ig.ObjectMeta.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateAWSImage(cloud awsup.AWSCloud, imageName string) error {
+	image, err := cloud.ResolveImage(imageName)
+	if err != nil {
+		return fmt.Errorf("unable to resolve image %q: %v", imageName, err)
+	}
+	if image == nil {
+		return fmt.Errorf("image %q not found", imageName)
+	}
+
+	if state := aws.StringValue(image.State); state != "available" {
+		return fmt.Errorf("image %q is in state %q, not available", imageName, state)
+	}
+
+	if virtType := aws.StringValue(image.VirtualizationType); virtType != "hvm" {
+		return fmt.Errorf("image %q has virtualization type %q; kubernetes requires an hvm image", imageName, virtType)
+	}
+
+	if arch := aws.StringValue(image.Architecture); arch != "x86_64" {
+		return fmt.Errorf("image %q has architecture %q; kubernetes requires x86_64", imageName, arch)
+	}
+
+	if !aws.BoolValue(image.EnaSupport) && aws.StringValue(image.SriovNetSupport) == "" {
+		// Not fatal: only an issue on instance types that require enhanced networking
+		glog.Warningf("image %q does not support enhanced networking (ena/sriov); some instance types will not boot it", imageName)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FlattenFields marshals the object through JSON and returns a map of dotted
+// field paths (e.g. "kubelet.image", "subnets[0].cidr") to rendered leaf
+// values, so two states of an options struct can be compared field by field.
+func FlattenFields(obj interface{}) (map[string]string, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling options: %v", err)
+	}
+
+	var tree interface{}
+	if err := json.Unmarshal(b, &tree); err != nil {
+		return nil, fmt.Errorf("error unmarshaling options: %v", err)
+	}
+
+	fields := make(map[string]string)
+	flattenInto(fields, "", tree)
+	return fields, nil
+}
+
+func flattenInto(fields map[string]string, prefix string, node interface{}) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenInto(fields, path, child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flattenInto(fields, fmt.Sprintf("%s[%d]", prefix, i), child)
+		}
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			fields[prefix] = fmt.Sprintf("%v", v)
+			return
+		}
+		fields[prefix] = string(b)
+	}
+}
+
+// recordChangedFields attributes to the source every field whose rendered
+// value differs between the two states, including fields the source removed.
+func recordChangedFields(attribution map[string]string, before map[string]string, after map[string]string, source string) {
+	for path, value := range after {
+		if beforeValue, found := before[path]; !found || beforeValue != value {
+			attribution[path] = source
+		}
+	}
+	for path := range before {
+		if _, found := after[path]; !found {
+			attribution[path] = source
+		}
+	}
+}
@@ -43,6 +43,11 @@ type OptionsLoader struct {
 	TemplateFunctions template.FuncMap
 
 	Builders []OptionsBuilder
+
+	// Attribution, when non-nil, records for each field path (as rendered by
+	// FlattenFields) the name of the template or builder that last changed
+	// it; fields taken unchanged from the user configuration get no entry
+	Attribution map[string]string
 }
 
 type OptionsBuilder interface {
@@ -97,6 +102,29 @@ func (l *OptionsLoader) iterate(userConfig interface{}, current interface{}) (in
 	// Copy the current state before applying rules; they act as defaults
 	utils.JsonMergeStruct(next, current)
 
+	// When attribution is enabled, snapshot the state around every template
+	// and builder so changed fields can be credited to their source
+	var fields map[string]string
+	if l.Attribution != nil {
+		f, err := FlattenFields(next)
+		if err != nil {
+			return nil, err
+		}
+		fields = f
+	}
+	recordSource := func(source string) error {
+		if l.Attribution == nil {
+			return nil
+		}
+		after, err := FlattenFields(next)
+		if err != nil {
+			return err
+		}
+		recordChangedFields(l.Attribution, fields, after, source)
+		fields = after
+		return nil
+	}
+
 	for _, t := range l.templates {
 		glog.V(2).Infof("executing template %s (tags=%s)", t.Name, t.Tags)
 
@@ -122,6 +150,10 @@ func (l *OptionsLoader) iterate(userConfig interface{}, current interface{}) (in
 		if err != nil {
 			return nil, fmt.Errorf("error parsing yaml (converted to JSON) %q: %v", t.Name, err)
 		}
+
+		if err := recordSource("template " + t.Name); err != nil {
+			return nil, err
+		}
 	}
 
 	for _, t := range l.Builders {
@@ -131,11 +163,19 @@ func (l *OptionsLoader) iterate(userConfig interface{}, current interface{}) (in
 		if err != nil {
 			return nil, err
 		}
+
+		if err := recordSource(strings.TrimPrefix(fmt.Sprintf("%T", t), "*")); err != nil {
+			return nil, err
+		}
 	}
 
 	// Also copy the user-provided values after applying rules; they act as overrides now
 	utils.JsonMergeStruct(next, userConfig)
 
+	if err := recordSource("user configuration"); err != nil {
+		return nil, err
+	}
+
 	return next, nil
 }
 
@@ -259,6 +259,14 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 		}
 	}
 
+	if c.instanceGroup != nil {
+		for i, image := range c.instanceGroup.Spec.PrePullImages {
+			taskMap["PullImage."+strconv.Itoa(i)] = &nodetasks.PullImageTask{
+				Name: image,
+			}
+		}
+	}
+
 	var cloud fi.Cloud
 	var keyStore fi.Keystore
 	var secretStore fi.SecretStore
@@ -0,0 +1,85 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetasks
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/golang/glog"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/cloudinit"
+	"k8s.io/kops/upup/pkg/fi/nodeup/local"
+)
+
+// PullImageTask is responsible for pulling a docker image from a registry,
+// used to pre-pull workload images before the node takes traffic
+type PullImageTask struct {
+	Name string
+}
+
+var _ fi.Task = &PullImageTask{}
+var _ fi.HasDependencies = &PullImageTask{}
+
+func (t *PullImageTask) GetDependencies(tasks map[string]fi.Task) []fi.Task {
+	// PullImageTask depends on the docker service to ensure we
+	// pull images after docker is completely updated and configured.
+	var deps []fi.Task
+	for _, v := range tasks {
+		if svc, ok := v.(*Service); ok && svc.Name == dockerService {
+			deps = append(deps, v)
+		}
+	}
+	return deps
+}
+
+func (t *PullImageTask) String() string {
+	return fmt.Sprintf("PullImageTask: %s", t.Name)
+}
+
+func (e *PullImageTask) Find(c *fi.Context) (*PullImageTask, error) {
+	glog.Warningf("PullImageTask checking if image present not yet implemented")
+	return nil, nil
+}
+
+func (e *PullImageTask) Run(c *fi.Context) error {
+	return fi.DefaultDeltaRunMethod(e, c)
+}
+
+func (_ *PullImageTask) CheckChanges(a, e, changes *PullImageTask) error {
+	return nil
+}
+
+func (_ *PullImageTask) RenderLocal(t *local.LocalTarget, a, e, changes *PullImageTask) error {
+	// Pull the image from the registry
+	args := []string{"docker", "pull", e.Name}
+	human := strings.Join(args, " ")
+
+	glog.Infof("running command %s", human)
+	cmd := exec.Command(args[0], args[1:]...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error pulling docker image with '%s': %v: %s", human, err, string(output))
+	}
+
+	return nil
+}
+
+func (_ *PullImageTask) RenderCloudInit(t *cloudinit.CloudInitTarget, a, e, changes *PullImageTask) error {
+	return fmt.Errorf("PullImageTask::RenderCloudInit not implemented")
+}